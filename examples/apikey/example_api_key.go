@@ -37,11 +37,13 @@ func main() {
 	// user discover api to find tv shows
 	tvShows, err := client.Discover.GetTVShows(
 		context.Background(),
-		tmdb.SingleQueryParam{Key: "with_genres", Value: "18"},
-		tmdb.SingleQueryParam{Key: "sort_by", Value: "popularity.desc"},
-		tmdb.SingleQueryParam{Key: "page", Value: 1},
-		tmdb.SingleQueryParam{Key: "language", Value: "en-US"},
-		tmdb.SingleQueryParam{Key: "with_status", Value: "2|3"}, // example of piping multiple values into query param
+		tmdb.DiscoverTVOptions{
+			WithGenres: tmdb.And(18),
+			SortBy:     tmdb.SortByPopularityDesc,
+			Page:       1,
+			Language:   "en-US",
+			WithStatus: tmdb.Or(tmdb.TVStatusInProduction, tmdb.TVStatusEnded), // shows in production or ended
+		},
 	)
 	if err != nil {
 		if tmdb.IsTmdbError(err) {
@@ -8,21 +8,35 @@ import (
 )
 
 type AccountService interface {
-	GetDetails(ctx context.Context, accountId string, queryParams ...queryParam) (*AccountDetails, error)
-	GetFavoriteMovies(ctx context.Context, accountId string, queryParams ...queryParam) (*FavoriteMoviesList, error)
-	GetFavoriteTVShows(ctx context.Context, accountId string, queryParams ...queryParam) (*FavoriteTVShowsList, error)
-	GetLists(ctx context.Context, accountId string, queryParams ...queryParam) (*AccountLists, error)
-	GetRatedMovies(ctx context.Context, accountId string, queryParams ...queryParam) (*RatedMovieList, error)
-	GetRatedTVShows(ctx context.Context, accountId string, queryParams ...queryParam) (*RatedTVShowsList, error)
-	GetRatedTVEpisodes(ctx context.Context, accountId string, queryParams ...queryParam) (*RatedTVShowEpisodesList, error)
-	GetMovieWatchlist(ctx context.Context, accountId string, queryParams ...queryParam) (*MovieWatchlist, error)
-	GetTVShowWatchlist(ctx context.Context, accountId string, queryParams ...queryParam) (*TVShowWatchlist, error)
+	GetDetails(ctx context.Context, accountId string, queryParams ...QueryParam) (*AccountDetails, error)
+	GetFavoriteMovies(ctx context.Context, accountId string, queryParams ...QueryParam) (*FavoriteMoviesList, error)
+	GetFavoriteTVShows(ctx context.Context, accountId string, queryParams ...QueryParam) (*FavoriteTVShowsList, error)
+	GetLists(ctx context.Context, accountId string, queryParams ...QueryParam) (*AccountLists, error)
+	GetRatedMovies(ctx context.Context, accountId string, queryParams ...QueryParam) (*RatedMovieList, error)
+	GetRatedTVShows(ctx context.Context, accountId string, queryParams ...QueryParam) (*RatedTVShowsList, error)
+	GetRatedTVEpisodes(ctx context.Context, accountId string, queryParams ...QueryParam) (*RatedTVShowEpisodesList, error)
+	GetMovieWatchlist(ctx context.Context, accountId string, queryParams ...QueryParam) (*MovieWatchlist, error)
+	GetTVShowWatchlist(ctx context.Context, accountId string, queryParams ...QueryParam) (*TVShowWatchlist, error)
+	AddFavorite(ctx context.Context, accountId string, mediaType string, mediaID int32, favorite bool, queryParams ...QueryParam) (*WriteResponse, error)
+	AddToWatchlist(ctx context.Context, accountId string, mediaType string, mediaID int32, watchlist bool, queryParams ...QueryParam) (*WriteResponse, error)
 }
 
 type AccountClient struct {
 	baseClient *Client
 }
 
+type favoriteBody struct {
+	MediaType string `json:"media_type"`
+	MediaID   int32  `json:"media_id"`
+	Favorite  bool   `json:"favorite"`
+}
+
+type watchlistBody struct {
+	MediaType string `json:"media_type"`
+	MediaID   int32  `json:"media_id"`
+	Watchlist bool   `json:"watchlist"`
+}
+
 type AccountDetails struct {
 	Avatar struct {
 		Gravatar struct {
@@ -40,178 +54,258 @@ type AccountDetails struct {
 	Username     string `json:"username"`
 }
 
+type FavoriteMovie struct {
+	Adult            bool    `json:"adult"`
+	BackdropPath     string  `json:"backdrop_path"`
+	GenreIds         []int32 `json:"genre_ids"`
+	ID               int32   `json:"id"`
+	OriginalLanguage string  `json:"original_language"`
+	OriginalTitle    string  `json:"original_title"`
+	Overview         string  `json:"overview"`
+	Popularity       float64 `json:"popularity"`
+	PosterPath       string  `json:"poster_path"`
+	ReleaseDate      string  `json:"release_date"`
+	Title            string  `json:"title"`
+	Video            bool    `json:"video"`
+	VoteAverage      float64 `json:"vote_average"`
+	VoteCount        int32   `json:"vote_count"`
+}
+
 type FavoriteMoviesList struct {
-	Page    int32 `json:"page"`
-	Results []struct {
-		Adult            bool    `json:"adult"`
-		BackdropPath     string  `json:"backdrop_path"`
-		GenreIds         []int32 `json:"genre_ids"`
-		ID               int32   `json:"id"`
-		OriginalLanguage string  `json:"original_language"`
-		OriginalTitle    string  `json:"original_title"`
-		Overview         string  `json:"overview"`
-		Popularity       float64 `json:"popularity"`
-		PosterPath       string  `json:"poster_path"`
-		ReleaseDate      string  `json:"release_date"`
-		Title            string  `json:"title"`
-		Video            bool    `json:"video"`
-		VoteAverage      float64 `json:"vote_average"`
-		VoteCount        int32   `json:"vote_count"`
-	} `json:"results"`
-	TotalPages   int32 `json:"total_pages"`
-	TotalResults int32 `json:"total_results"`
+	Page         int32           `json:"page"`
+	Results      []FavoriteMovie `json:"results"`
+	TotalPages   int32           `json:"total_pages"`
+	TotalResults int32           `json:"total_results"`
+}
+
+func (r *FavoriteMoviesList) pageInfo() (page, totalPages int) {
+	return int(r.Page), int(r.TotalPages)
+}
+
+func (r *FavoriteMoviesList) results() []FavoriteMovie {
+	return r.Results
+}
+
+type FavoriteTVShow struct {
+	Adult            bool     `json:"adult"`
+	BackdropPath     string   `json:"backdrop_path"`
+	FirstAirDate     string   `json:"first_air_date"`
+	GenreIds         []int32  `json:"genre_ids"`
+	ID               int32    `json:"id"`
+	Name             string   `json:"name"`
+	OriginCountry    []string `json:"origin_country"`
+	OriginalLanguage string   `json:"original_language"`
+	OriginalName     string   `json:"original_name"`
+	Overview         string   `json:"overview"`
+	Popularity       float64  `json:"popularity"`
+	PosterPath       string   `json:"poster_path"`
+	VoteAverage      float64  `json:"vote_average"`
+	VoteCount        int32    `json:"vote_count"`
 }
 
 type FavoriteTVShowsList struct {
-	Page    int32 `json:"page"`
-	Results []struct {
-		Adult            bool     `json:"adult"`
-		BackdropPath     string   `json:"backdrop_path"`
-		FirstAirDate     string   `json:"first_air_date"`
-		GenreIds         []int32  `json:"genre_ids"`
-		ID               int32    `json:"id"`
-		Name             string   `json:"name"`
-		OriginCountry    []string `json:"origin_country"`
-		OriginalLanguage string   `json:"original_language"`
-		OriginalName     string   `json:"original_name"`
-		Overview         string   `json:"overview"`
-		Popularity       float64  `json:"popularity"`
-		PosterPath       string   `json:"poster_path"`
-		VoteAverage      float64  `json:"vote_average"`
-		VoteCount        int32    `json:"vote_count"`
-	} `json:"results"`
-	TotalPages   int32 `json:"total_pages"`
-	TotalResults int32 `json:"total_results"`
+	Page         int32            `json:"page"`
+	Results      []FavoriteTVShow `json:"results"`
+	TotalPages   int32            `json:"total_pages"`
+	TotalResults int32            `json:"total_results"`
+}
+
+func (r *FavoriteTVShowsList) pageInfo() (page, totalPages int) {
+	return int(r.Page), int(r.TotalPages)
+}
+
+func (r *FavoriteTVShowsList) results() []FavoriteTVShow {
+	return r.Results
+}
+
+type AccountList struct {
+	Description   string `json:"description"`
+	FavoriteCount int32  `json:"favorite_count"`
+	ID            string `json:"id"`
+	ItemCount     int32  `json:"item_count"`
+	Iso_639_1     string `json:"iso_639_1"`
+	ListType      string `json:"list_type"`
+	Name          string `json:"name"`
+	PosterPath    string `json:"poster_path"`
 }
 
 type AccountLists struct {
-	Page    int32 `json:"page"`
-	Results []struct {
-		Description   string `json:"description"`
-		FavoriteCount int32  `json:"favorite_count"`
-		ID            string `json:"id"`
-		ItemCount     int32  `json:"item_count"`
-		Iso_639_1     string `json:"iso_639_1"`
-		ListType      string `json:"list_type"`
-		Name          string `json:"name"`
-		PosterPath    string `json:"poster_path"`
-	} `json:"results"`
-	TotalPages   int32 `json:"total_pages"`
-	TotalResults int32 `json:"total_results"`
+	Page         int32         `json:"page"`
+	Results      []AccountList `json:"results"`
+	TotalPages   int32         `json:"total_pages"`
+	TotalResults int32         `json:"total_results"`
+}
+
+func (r *AccountLists) pageInfo() (page, totalPages int) {
+	return int(r.Page), int(r.TotalPages)
+}
+
+func (r *AccountLists) results() []AccountList {
+	return r.Results
+}
+
+type RatedMovie struct {
+	Adult            bool    `json:"adult"`
+	BackdropPath     string  `json:"backdrop_path"`
+	GenreIds         []int32 `json:"genre_ids"`
+	ID               int32   `json:"id"`
+	OriginalLanguage string  `json:"original_language"`
+	OriginalTitle    string  `json:"original_title"`
+	Overview         string  `json:"overview"`
+	Popularity       float64 `json:"popularity"`
+	PosterPath       string  `json:"poster_path"`
+	ReleaseDate      string  `json:"release_date"`
+	Title            string  `json:"title"`
+	Video            bool    `json:"video"`
+	VoteAverage      float64 `json:"vote_average"`
+	VoteCount        int32   `json:"vote_count"`
+	Rating           float64 `json:"rating"`
 }
 
 type RatedMovieList struct {
-	Page    int32 `json:"page"`
-	Results []struct {
-		Adult            bool    `json:"adult"`
-		BackdropPath     string  `json:"backdrop_path"`
-		GenreIds         []int32 `json:"genre_ids"`
-		ID               int32   `json:"id"`
-		OriginalLanguage string  `json:"original_language"`
-		OriginalTitle    string  `json:"original_title"`
-		Overview         string  `json:"overview"`
-		Popularity       float64 `json:"popularity"`
-		PosterPath       string  `json:"poster_path"`
-		ReleaseDate      string  `json:"release_date"`
-		Title            string  `json:"title"`
-		Video            bool    `json:"video"`
-		VoteAverage      float64 `json:"vote_average"`
-		VoteCount        int32   `json:"vote_count"`
-		Rating           float64 `json:"rating"`
-	} `json:"results"`
-	TotalPages   int32 `json:"total_pages"`
-	TotalResults int32 `json:"total_results"`
+	Page         int32        `json:"page"`
+	Results      []RatedMovie `json:"results"`
+	TotalPages   int32        `json:"total_pages"`
+	TotalResults int32        `json:"total_results"`
+}
+
+func (r *RatedMovieList) pageInfo() (page, totalPages int) {
+	return int(r.Page), int(r.TotalPages)
+}
+
+func (r *RatedMovieList) results() []RatedMovie {
+	return r.Results
+}
+
+type RatedTVShow struct {
+	Adult            bool     `json:"adult"`
+	BackdropPath     string   `json:"backdrop_path"`
+	FirstAirDate     string   `json:"first_air_date"`
+	GenreIds         []int32  `json:"genre_ids"`
+	ID               int32    `json:"id"`
+	Name             string   `json:"name"`
+	OriginCountry    []string `json:"origin_country"`
+	OriginalLanguage string   `json:"original_language"`
+	OriginalName     string   `json:"original_name"`
+	Overview         string   `json:"overview"`
+	Popularity       float64  `json:"popularity"`
+	PosterPath       string   `json:"poster_path"`
+	VoteAverage      float64  `json:"vote_average"`
+	VoteCount        int32    `json:"vote_count"`
+	Rating           float64  `json:"rating"`
 }
 
 type RatedTVShowsList struct {
-	Page    int32 `json:"page"`
-	Results []struct {
-		Adult            bool     `json:"adult"`
-		BackdropPath     string   `json:"backdrop_path"`
-		FirstAirDate     string   `json:"first_air_date"`
-		GenreIds         []int32  `json:"genre_ids"`
-		ID               int32    `json:"id"`
-		Name             string   `json:"name"`
-		OriginCountry    []string `json:"origin_country"`
-		OriginalLanguage string   `json:"original_language"`
-		OriginalName     string   `json:"original_name"`
-		Overview         string   `json:"overview"`
-		Popularity       float64  `json:"popularity"`
-		PosterPath       string   `json:"poster_path"`
-		VoteAverage      float64  `json:"vote_average"`
-		VoteCount        int32    `json:"vote_count"`
-		Rating           float64  `json:"rating"`
-	} `json:"results"`
-	TotalPages   int32 `json:"total_pages"`
-	TotalResults int32 `json:"total_results"`
+	Page         int32         `json:"page"`
+	Results      []RatedTVShow `json:"results"`
+	TotalPages   int32         `json:"total_pages"`
+	TotalResults int32         `json:"total_results"`
+}
+
+func (r *RatedTVShowsList) pageInfo() (page, totalPages int) {
+	return int(r.Page), int(r.TotalPages)
+}
+
+func (r *RatedTVShowsList) results() []RatedTVShow {
+	return r.Results
+}
+
+type RatedTVShowEpisode struct {
+	AirDate        string  `json:"air_date"`
+	EpisodeNumber  int32   `json:"episode_number"`
+	ID             int32   `json:"id"`
+	Name           string  `json:"name"`
+	Overview       string  `json:"overview"`
+	ProductionCode string  `json:"production_code"`
+	Runtime        int32   `json:"runtime"`
+	SeasonNumber   int32   `json:"season_number"`
+	ShowID         int32   `json:"show_id"`
+	StillPath      string  `json:"still_path"`
+	VoteAverage    float64 `json:"vote_average"`
+	VoteCount      int32   `json:"vote_count"`
+	Rating         float64 `json:"rating"`
 }
 
 type RatedTVShowEpisodesList struct {
-	Page    int32 `json:"page"`
-	Results []struct {
-		AirDate        string  `json:"air_date"`
-		EpisodeNumber  int32   `json:"episode_number"`
-		ID             int32   `json:"id"`
-		Name           string  `json:"name"`
-		Overview       string  `json:"overview"`
-		ProductionCode string  `json:"production_code"`
-		Runtime        int32   `json:"runtime"`
-		SeasonNumber   int32   `json:"season_number"`
-		ShowID         int32   `json:"show_id"`
-		StillPath      string  `json:"still_path"`
-		VoteAverage    float64 `json:"vote_average"`
-		VoteCount      int32   `json:"vote_count"`
-		Rating         float64 `json:"rating"`
-	} `json:"results"`
-	TotalPages   int32 `json:"total_pages"`
-	TotalResults int32 `json:"total_results"`
+	Page         int32                `json:"page"`
+	Results      []RatedTVShowEpisode `json:"results"`
+	TotalPages   int32                `json:"total_pages"`
+	TotalResults int32                `json:"total_results"`
+}
+
+func (r *RatedTVShowEpisodesList) pageInfo() (page, totalPages int) {
+	return int(r.Page), int(r.TotalPages)
+}
+
+func (r *RatedTVShowEpisodesList) results() []RatedTVShowEpisode {
+	return r.Results
+}
+
+type WatchlistMovie struct {
+	Adult            bool    `json:"adult"`
+	BackdropPath     string  `json:"backdrop_path"`
+	GenreIds         []int32 `json:"genre_ids"`
+	ID               int32   `json:"id"`
+	OriginalLanguage string  `json:"original_language"`
+	OriginalTitle    string  `json:"original_title"`
+	Overview         string  `json:"overview"`
+	Popularity       float64 `json:"popularity"`
+	PosterPath       string  `json:"poster_path"`
+	ReleaseDate      string  `json:"release_date"`
+	Title            string  `json:"title"`
+	Video            bool    `json:"video"`
+	VoteAverage      float64 `json:"vote_average"`
+	VoteCount        int32   `json:"vote_count"`
 }
 
 type MovieWatchlist struct {
-	Page    int32 `json:"page"`
-	Results []struct {
-		Adult            bool    `json:"adult"`
-		BackdropPath     string  `json:"backdrop_path"`
-		GenreIds         []int32 `json:"genre_ids"`
-		ID               int32   `json:"id"`
-		OriginalLanguage string  `json:"original_language"`
-		OriginalTitle    string  `json:"original_title"`
-		Overview         string  `json:"overview"`
-		Popularity       float64 `json:"popularity"`
-		PosterPath       string  `json:"poster_path"`
-		ReleaseDate      string  `json:"release_date"`
-		Title            string  `json:"title"`
-		Video            bool    `json:"video"`
-		VoteAverage      float64 `json:"vote_average"`
-		VoteCount        int32   `json:"vote_count"`
-	} `json:"results"`
-	TotalPages   int32 `json:"total_pages"`
-	TotalResults int32 `json:"total_results"`
+	Page         int32            `json:"page"`
+	Results      []WatchlistMovie `json:"results"`
+	TotalPages   int32            `json:"total_pages"`
+	TotalResults int32            `json:"total_results"`
+}
+
+func (r *MovieWatchlist) pageInfo() (page, totalPages int) {
+	return int(r.Page), int(r.TotalPages)
+}
+
+func (r *MovieWatchlist) results() []WatchlistMovie {
+	return r.Results
+}
+
+type WatchlistTVShow struct {
+	Adult            bool     `json:"adult"`
+	BackdropPath     string   `json:"backdrop_path"`
+	FirstAirDate     string   `json:"first_air_date"`
+	GenreIds         []int32  `json:"genre_ids"`
+	ID               int32    `json:"id"`
+	Name             string   `json:"name"`
+	OriginCountry    []string `json:"origin_country"`
+	OriginalLanguage string   `json:"original_language"`
+	OriginalName     string   `json:"original_name"`
+	Overview         string   `json:"overview"`
+	Popularity       float64  `json:"popularity"`
+	PosterPath       string   `json:"poster_path"`
+	VoteAverage      float64  `json:"vote_average"`
+	VoteCount        int32    `json:"vote_count"`
 }
 
 type TVShowWatchlist struct {
-	Page    int32 `json:"page"`
-	Results []struct {
-		Adult            bool     `json:"adult"`
-		BackdropPath     string   `json:"backdrop_path"`
-		FirstAirDate     string   `json:"first_air_date"`
-		GenreIds         []int32  `json:"genre_ids"`
-		ID               int32    `json:"id"`
-		Name             string   `json:"name"`
-		OriginCountry    []string `json:"origin_country"`
-		OriginalLanguage string   `json:"original_language"`
-		OriginalName     string   `json:"original_name"`
-		Overview         string   `json:"overview"`
-		Popularity       float64  `json:"popularity"`
-		PosterPath       string   `json:"poster_path"`
-		VoteAverage      float64  `json:"vote_average"`
-		VoteCount        int32    `json:"vote_count"`
-	} `json:"results"`
-	TotalPages   int32 `json:"total_pages"`
-	TotalResults int32 `json:"total_results"`
-}
-
-func (ac *AccountClient) GetDetails(ctx context.Context, accountId string, queryParams ...queryParam) (*AccountDetails, error) {
+	Page         int32             `json:"page"`
+	Results      []WatchlistTVShow `json:"results"`
+	TotalPages   int32             `json:"total_pages"`
+	TotalResults int32             `json:"total_results"`
+}
+
+func (r *TVShowWatchlist) pageInfo() (page, totalPages int) {
+	return int(r.Page), int(r.TotalPages)
+}
+
+func (r *TVShowWatchlist) results() []WatchlistTVShow {
+	return r.Results
+}
+
+func (ac *AccountClient) GetDetails(ctx context.Context, accountId string, queryParams ...QueryParam) (*AccountDetails, error) {
 	resp, err := ac.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/account/%s", accountId), queryParams...)
 	if err != nil {
 		return nil, err
@@ -225,7 +319,7 @@ func (ac *AccountClient) GetDetails(ctx context.Context, accountId string, query
 	return &result, nil
 }
 
-func (ac *AccountClient) GetFavoriteMovies(ctx context.Context, accountId string, queryParams ...queryParam) (*FavoriteMoviesList, error) {
+func (ac *AccountClient) GetFavoriteMovies(ctx context.Context, accountId string, queryParams ...QueryParam) (*FavoriteMoviesList, error) {
 	resp, err := ac.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/account/%s/favorite/movies", accountId), queryParams...)
 	if err != nil {
 		return nil, err
@@ -239,7 +333,7 @@ func (ac *AccountClient) GetFavoriteMovies(ctx context.Context, accountId string
 	return &result, nil
 }
 
-func (ac *AccountClient) GetFavoriteTVShows(ctx context.Context, accountId string, queryParams ...queryParam) (*FavoriteTVShowsList, error) {
+func (ac *AccountClient) GetFavoriteTVShows(ctx context.Context, accountId string, queryParams ...QueryParam) (*FavoriteTVShowsList, error) {
 	resp, err := ac.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/account/%s/favorite/tv", accountId), queryParams...)
 	if err != nil {
 		return nil, err
@@ -253,7 +347,7 @@ func (ac *AccountClient) GetFavoriteTVShows(ctx context.Context, accountId strin
 	return &result, nil
 }
 
-func (ac *AccountClient) GetLists(ctx context.Context, accountId string, queryParams ...queryParam) (*AccountLists, error) {
+func (ac *AccountClient) GetLists(ctx context.Context, accountId string, queryParams ...QueryParam) (*AccountLists, error) {
 	resp, err := ac.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/account/%s/lists", accountId), queryParams...)
 	if err != nil {
 		return nil, err
@@ -267,7 +361,7 @@ func (ac *AccountClient) GetLists(ctx context.Context, accountId string, queryPa
 	return &result, nil
 }
 
-func (ac *AccountClient) GetRatedMovies(ctx context.Context, accountId string, queryParams ...queryParam) (*RatedMovieList, error) {
+func (ac *AccountClient) GetRatedMovies(ctx context.Context, accountId string, queryParams ...QueryParam) (*RatedMovieList, error) {
 	resp, err := ac.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/account/%s/rated/movies", accountId), queryParams...)
 	if err != nil {
 		return nil, err
@@ -281,7 +375,7 @@ func (ac *AccountClient) GetRatedMovies(ctx context.Context, accountId string, q
 	return &result, nil
 }
 
-func (ac *AccountClient) GetRatedTVShows(ctx context.Context, accountId string, queryParams ...queryParam) (*RatedTVShowsList, error) {
+func (ac *AccountClient) GetRatedTVShows(ctx context.Context, accountId string, queryParams ...QueryParam) (*RatedTVShowsList, error) {
 	resp, err := ac.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/account/%s/rated/tv", accountId), queryParams...)
 	if err != nil {
 		return nil, err
@@ -295,7 +389,7 @@ func (ac *AccountClient) GetRatedTVShows(ctx context.Context, accountId string,
 	return &result, nil
 }
 
-func (ac *AccountClient) GetRatedTVEpisodes(ctx context.Context, accountId string, queryParams ...queryParam) (*RatedTVShowEpisodesList, error) {
+func (ac *AccountClient) GetRatedTVEpisodes(ctx context.Context, accountId string, queryParams ...QueryParam) (*RatedTVShowEpisodesList, error) {
 	resp, err := ac.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/account/%s/rated/tv/episodes", accountId), queryParams...)
 	if err != nil {
 		return nil, err
@@ -309,7 +403,7 @@ func (ac *AccountClient) GetRatedTVEpisodes(ctx context.Context, accountId strin
 	return &result, nil
 }
 
-func (ac *AccountClient) GetMovieWatchlist(ctx context.Context, accountId string, queryParams ...queryParam) (*MovieWatchlist, error) {
+func (ac *AccountClient) GetMovieWatchlist(ctx context.Context, accountId string, queryParams ...QueryParam) (*MovieWatchlist, error) {
 	resp, err := ac.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/account/%s/watchlist/movies", accountId), queryParams...)
 	if err != nil {
 		return nil, err
@@ -323,7 +417,7 @@ func (ac *AccountClient) GetMovieWatchlist(ctx context.Context, accountId string
 	return &result, nil
 }
 
-func (ac *AccountClient) GetTVShowWatchlist(ctx context.Context, accountId string, queryParams ...queryParam) (*TVShowWatchlist, error) {
+func (ac *AccountClient) GetTVShowWatchlist(ctx context.Context, accountId string, queryParams ...QueryParam) (*TVShowWatchlist, error) {
 	resp, err := ac.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/account/%s/watchlist/tv", accountId), queryParams...)
 	if err != nil {
 		return nil, err
@@ -336,3 +430,38 @@ func (ac *AccountClient) GetTVShowWatchlist(ctx context.Context, accountId strin
 	}
 	return &result, nil
 }
+
+// AddFavorite marks the movie or tv series mediaID as favorite, or unmarks it when favorite is false.
+// mediaType is MediaTypeMovie or MediaTypeTv. It needs the session_id of the account, e.g. SessionID.
+func (ac *AccountClient) AddFavorite(ctx context.Context, accountId string, mediaType string, mediaID int32, favorite bool, queryParams ...QueryParam) (*WriteResponse, error) {
+	if err := checkAccountMediaType(mediaType); err != nil {
+		return nil, err
+	}
+	if err := ac.baseClient.requireSession(queryParams, "session_id"); err != nil {
+		return nil, err
+	}
+
+	body := favoriteBody{MediaType: mediaType, MediaID: mediaID, Favorite: favorite}
+	return ac.baseClient.write(ctx, http.MethodPost, fmt.Sprintf("/account/%s/favorite", accountId), body, queryParams...)
+}
+
+// AddToWatchlist adds the movie or tv series mediaID to the watchlist, or removes it when watchlist is false.
+// mediaType is MediaTypeMovie or MediaTypeTv. It needs the session_id of the account, e.g. SessionID.
+func (ac *AccountClient) AddToWatchlist(ctx context.Context, accountId string, mediaType string, mediaID int32, watchlist bool, queryParams ...QueryParam) (*WriteResponse, error) {
+	if err := checkAccountMediaType(mediaType); err != nil {
+		return nil, err
+	}
+	if err := ac.baseClient.requireSession(queryParams, "session_id"); err != nil {
+		return nil, err
+	}
+
+	body := watchlistBody{MediaType: mediaType, MediaID: mediaID, Watchlist: watchlist}
+	return ac.baseClient.write(ctx, http.MethodPost, fmt.Sprintf("/account/%s/watchlist", accountId), body, queryParams...)
+}
+
+func checkAccountMediaType(mediaType string) error {
+	if mediaType != MediaTypeMovie && mediaType != MediaTypeTv {
+		return fmt.Errorf("%w: media type must be %s or %s, got %q", ErrInvalidQueryParams, MediaTypeMovie, MediaTypeTv, mediaType)
+	}
+	return nil
+}
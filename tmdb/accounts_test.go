@@ -0,0 +1,101 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+)
+
+type recordedWrite struct {
+	method string
+	path   string
+	query  string
+	body   string
+}
+
+func newWriteServer(writes *[]recordedWrite) (*Client, func()) {
+	testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		*writes = append(*writes, recordedWrite{r.Method, r.URL.Path, r.URL.RawQuery, string(body)})
+		_, _ = w.Write([]byte(`{"success":true,"status_code":1,"status_message":"Success."}`))
+	})
+	return testClient, testServer.Close
+}
+
+func TestAccountWrites(t *testing.T) {
+	t.Run("Favorites and watchlist", func(t *testing.T) {
+		var writes []recordedWrite
+		testClient, closeServer := newWriteServer(&writes)
+		defer closeServer()
+
+		ctx := context.Background()
+		if _, err := testClient.Accounts.AddFavorite(ctx, "548", MediaTypeMovie, 18148, true, SessionID("abc")); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := testClient.Accounts.AddToWatchlist(ctx, "548", MediaTypeTv, 1396, false, SessionID("abc")); err != nil {
+			t.Fatal(err)
+		}
+
+		expected := []recordedWrite{
+			{http.MethodPost, "/3/account/548/favorite", "session_id=abc", `{"media_type":"movie","media_id":18148,"favorite":true}`},
+			{http.MethodPost, "/3/account/548/watchlist", "session_id=abc", `{"media_type":"tv","media_id":1396,"watchlist":false}`},
+		}
+		for i, write := range expected {
+			if i >= len(writes) || writes[i] != write {
+				t.Errorf("expected %+v, got %+v", write, writes)
+			}
+		}
+
+		if _, err := testClient.Accounts.AddFavorite(ctx, "548", MediaTypeMovie, 18148, true, GuestSessionID("guest")); !errors.Is(err, ErrSessionMissing) {
+			t.Errorf("expected ErrSessionMissing, got %v", err)
+		}
+		if _, err := testClient.Accounts.AddToWatchlist(ctx, "548", MediaTypePerson, 1, true, SessionID("abc")); !errors.Is(err, ErrInvalidQueryParams) {
+			t.Errorf("expected ErrInvalidQueryParams, got %v", err)
+		}
+		if len(writes) != 2 {
+			t.Errorf("expected invalid writes not to be sent, got %d writes", len(writes))
+		}
+	})
+
+	t.Run("Ratings", func(t *testing.T) {
+		var writes []recordedWrite
+		testClient, closeServer := newWriteServer(&writes)
+		defer closeServer()
+
+		ctx := context.Background()
+		if _, err := testClient.Movies.AddRating(ctx, 18148, 8.5, GuestSessionID("guest")); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := testClient.TvSeries.AddRating(ctx, 1396, 10, SessionID("abc")); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := testClient.TvEpisodes.DeleteRating(ctx, 1396, 1, 1, SessionID("abc")); err != nil {
+			t.Fatal(err)
+		}
+
+		expected := []recordedWrite{
+			{http.MethodPost, "/3/movie/18148/rating", "guest_session_id=guest", `{"value":8.5}`},
+			{http.MethodPost, "/3/tv/1396/rating", "session_id=abc", `{"value":10}`},
+			{http.MethodDelete, "/3/tv/1396/season/1/episode/1/rating", "session_id=abc", ``},
+		}
+		for i, write := range expected {
+			if i >= len(writes) || writes[i] != write {
+				t.Errorf("expected %+v, got %+v", write, writes)
+			}
+		}
+
+		for _, rating := range []float64{0, 0.25, 7.3, 10.5} {
+			if _, err := testClient.Movies.AddRating(ctx, 18148, rating, SessionID("abc")); !errors.Is(err, ErrInvalidRating) {
+				t.Errorf("expected ErrInvalidRating for %v, got %v", rating, err)
+			}
+		}
+		if _, err := testClient.Movies.DeleteRating(ctx, 18148); !errors.Is(err, ErrSessionMissing) {
+			t.Errorf("expected ErrSessionMissing, got %v", err)
+		}
+		if len(writes) != 3 {
+			t.Errorf("expected invalid writes not to be sent, got %d writes", len(writes))
+		}
+	})
+}
@@ -0,0 +1,87 @@
+package tmdb
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	appendToResponseKey = "append_to_response"
+	// maxAppendToResponse is the number of sub-resources TMDb accepts in one request.
+	maxAppendToResponse = 20
+)
+
+// AppendToResponse names a sub-resource fetched in the same request as a details endpoint.
+// Pass them to the GetDetailsWith methods, which fill in the matching fields of the response.
+type AppendToResponse string
+
+const (
+	AppendAggregateCredits     AppendToResponse = "aggregate_credits"
+	AppendAlternativeTitles    AppendToResponse = "alternative_titles"
+	AppendCombinedCredits      AppendToResponse = "combined_credits"
+	AppendContentRatings       AppendToResponse = "content_ratings"
+	AppendCredits              AppendToResponse = "credits"
+	AppendEpisodeGroups        AppendToResponse = "episode_groups"
+	AppendExternalIDs          AppendToResponse = "external_ids"
+	AppendImages               AppendToResponse = "images"
+	AppendKeywords             AppendToResponse = "keywords"
+	AppendLists                AppendToResponse = "lists"
+	AppendMovieCredits         AppendToResponse = "movie_credits"
+	AppendRecommendations      AppendToResponse = "recommendations"
+	AppendReleaseDates         AppendToResponse = "release_dates"
+	AppendReviews              AppendToResponse = "reviews"
+	AppendScreenedTheatrically AppendToResponse = "screened_theatrically"
+	AppendSimilar              AppendToResponse = "similar"
+	AppendTranslations         AppendToResponse = "translations"
+	AppendTVCredits            AppendToResponse = "tv_credits"
+	AppendVideos               AppendToResponse = "videos"
+	AppendWatchProviders       AppendToResponse = "watch/providers"
+)
+
+func (a AppendToResponse) GetKey() string {
+	return appendToResponseKey
+}
+
+// Apply adds the sub-resource to the comma separated list, so several appends share one param.
+func (a AppendToResponse) Apply(v url.Values) {
+	existing := v.Get(appendToResponseKey)
+	if existing == "" {
+		v.Set(appendToResponseKey, string(a))
+		return
+	}
+	for _, name := range strings.Split(existing, ",") {
+		if name == string(a) {
+			return
+		}
+	}
+	v.Set(appendToResponseKey, existing+","+string(a))
+}
+
+// checkAppends makes sure every AppendToResponse in queryParams can be appended to the resource.
+func checkAppends(resource string, supported []AppendToResponse, queryParams []QueryParam) error {
+	seen := make(map[AppendToResponse]bool)
+	for _, param := range queryParams {
+		a, ok := param.(AppendToResponse)
+		if !ok {
+			continue
+		}
+		if !containsAppend(supported, a) {
+			return fmt.Errorf("%w: %q cannot be appended to %s", ErrInvalidQueryParams, a, resource)
+		}
+		seen[a] = true
+	}
+	if len(seen) > maxAppendToResponse {
+		return fmt.Errorf("%w: at most %d sub-resources can be appended, got %d", ErrInvalidQueryParams, maxAppendToResponse, len(seen))
+	}
+	return nil
+}
+
+func containsAppend(appends []AppendToResponse, a AppendToResponse) bool {
+	for _, supported := range appends {
+		if supported == a {
+			return true
+		}
+	}
+	return false
+}
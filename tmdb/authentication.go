@@ -10,6 +10,10 @@ type AuthenticationService interface {
 	CreateGuestSession(ctx context.Context) (*GuestSessionResponse, error)
 	CreateRequestToken(ctx context.Context) (*RequestTokenResponse, error)
 	ValidateKey(ctx context.Context) (*ValidateResponse, error)
+	ValidateWithLogin(ctx context.Context, username, password, requestToken string) (*RequestTokenResponse, error)
+	CreateSession(ctx context.Context, requestToken string) (*SessionResponse, error)
+	CreateSessionFromV4Token(ctx context.Context, accessToken string) (*SessionResponse, error)
+	DeleteSession(ctx context.Context, sessionID string) (*WriteResponse, error)
 }
 
 type AuthenticationClient struct {
@@ -28,6 +32,11 @@ type RequestTokenResponse struct {
 	RequestToken string `json:"request_token"`
 }
 
+type SessionResponse struct {
+	Success   bool   `json:"success"`
+	SessionID string `json:"session_id"`
+}
+
 type ValidateResponse struct {
 	Success       bool   `json:"success"`
 	StatusCode    int    `json:"status_code"`
@@ -75,3 +84,50 @@ func (ac *AuthenticationClient) ValidateKey(ctx context.Context) (*ValidateRespo
 	}
 	return &result, nil
 }
+
+// ValidateWithLogin approves requestToken with the username and password of a TMDb account,
+// instead of sending the user to the TMDb website. The body is sent over TLS and never logged.
+func (ac *AuthenticationClient) ValidateWithLogin(ctx context.Context, username, password, requestToken string) (*RequestTokenResponse, error) {
+	body := map[string]string{"username": username, "password": password, "request_token": requestToken}
+	resp, err := ac.baseClient.requestWithBody(ctx, http.MethodPost, "/authentication/token/validate_with_login", body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result RequestTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateSession exchanges an approved requestToken for the session of the user who approved it.
+// Use the session with Client.WithSession.
+func (ac *AuthenticationClient) CreateSession(ctx context.Context, requestToken string) (*SessionResponse, error) {
+	return ac.createSession(ctx, "/authentication/session/new", map[string]string{"request_token": requestToken})
+}
+
+// CreateSessionFromV4Token exchanges a v4 access token for a v3 session of the same user.
+func (ac *AuthenticationClient) CreateSessionFromV4Token(ctx context.Context, accessToken string) (*SessionResponse, error) {
+	return ac.createSession(ctx, "/authentication/session/convert/4", map[string]string{"access_token": accessToken})
+}
+
+func (ac *AuthenticationClient) createSession(ctx context.Context, path string, body map[string]string) (*SessionResponse, error) {
+	resp, err := ac.baseClient.requestWithBody(ctx, http.MethodPost, path, body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result SessionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteSession logs the user of sessionID out.
+func (ac *AuthenticationClient) DeleteSession(ctx context.Context, sessionID string) (*WriteResponse, error) {
+	return ac.baseClient.write(ctx, http.MethodDelete, "/authentication/session", map[string]string{"session_id": sessionID})
+}
@@ -0,0 +1,73 @@
+package tmdb
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestAuthenticationClient(t *testing.T) {
+	t.Run("Login flow and session scoped client", func(t *testing.T) {
+		var requests []recordedWrite
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			requests = append(requests, recordedWrite{r.Method, r.URL.Path, r.URL.RawQuery, string(body)})
+			switch r.URL.Path {
+			case "/3/authentication/token/validate_with_login":
+				_, _ = w.Write([]byte(`{"success":true,"expires_at":"2024-05-15 09:00:00 UTC","request_token":"token"}`))
+			case "/3/authentication/session/new":
+				_, _ = w.Write([]byte(`{"success":true,"session_id":"79191836ddaa0da3df76a5ffef6f07ad6ab0c641"}`))
+			default:
+				_, _ = w.Write([]byte(`{"success":true}`))
+			}
+		})
+		defer testServer.Close()
+
+		ctx := context.Background()
+		token, err := testClient.Authentication.ValidateWithLogin(ctx, "johnny_appleseed", "test123", "token")
+		if err != nil {
+			t.Fatal(err)
+		}
+		session, err := testClient.Authentication.CreateSession(ctx, token.RequestToken)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		userClient := testClient.WithSession(session.SessionID)
+		if _, err := userClient.Movies.GetAccountStates(ctx, 18148); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := userClient.Accounts.AddFavorite(ctx, "548", MediaTypeMovie, 18148, true); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := userClient.Movies.GetDetails(ctx, 18148); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := testClient.Movies.GetAccountStates(ctx, 18148); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := testClient.Authentication.DeleteSession(ctx, session.SessionID); err != nil {
+			t.Fatal(err)
+		}
+
+		sessionQuery := "session_id=79191836ddaa0da3df76a5ffef6f07ad6ab0c641"
+		expected := []recordedWrite{
+			{http.MethodPost, "/3/authentication/token/validate_with_login", "", `{"password":"test123","request_token":"token","username":"johnny_appleseed"}`},
+			{http.MethodPost, "/3/authentication/session/new", "", `{"request_token":"token"}`},
+			{http.MethodGet, "/3/movie/18148/account_states", sessionQuery, ""},
+			{http.MethodPost, "/3/account/548/favorite", sessionQuery, `{"media_type":"movie","media_id":18148,"favorite":true}`},
+			{http.MethodGet, "/3/movie/18148", "", ""},
+			{http.MethodGet, "/3/movie/18148/account_states", "", ""},
+			{http.MethodDelete, "/3/authentication/session", "", `{"session_id":"79191836ddaa0da3df76a5ffef6f07ad6ab0c641"}`},
+		}
+		if len(requests) != len(expected) {
+			t.Fatalf("expected %d requests, got %+v", len(expected), requests)
+		}
+		for i, request := range expected {
+			if requests[i] != request {
+				t.Errorf("expected %+v, got %+v", request, requests[i])
+			}
+		}
+	})
+}
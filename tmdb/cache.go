@@ -0,0 +1,297 @@
+package tmdb
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheStore stores responses of GET requests. Implementations must be safe for concurrent use.
+// Caching is best effort, so stores swallow their own errors and report them as misses.
+type CacheStore interface {
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, entry *CacheEntry)
+	Delete(key string)
+}
+
+// CacheEntry is a cached response. Entries handed to a CacheStore must not be modified afterwards.
+type CacheEntry struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+	StoredAt   time.Time   `json:"stored_at"`
+	ExpiresAt  time.Time   `json:"expires_at"`
+}
+
+// Fresh reports whether the entry can be served without revalidating it with TMDb.
+func (e *CacheEntry) Fresh(now time.Time) bool {
+	return now.Before(e.ExpiresAt)
+}
+
+// validators returns the conditional request headers used to revalidate the entry.
+func (e *CacheEntry) validators() http.Header {
+	header := http.Header{}
+	if etag := e.Header.Get("ETag"); etag != "" {
+		header.Set("If-None-Match", etag)
+	}
+	if lastModified := e.Header.Get("Last-Modified"); lastModified != "" {
+		header.Set("If-Modified-Since", lastModified)
+	}
+	return header
+}
+
+func (e *CacheEntry) response() *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode)),
+		StatusCode:    e.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+	}
+}
+
+type cacheTTL struct {
+	pattern string
+	ttl     time.Duration
+}
+
+// WithCache caches GET responses in store. Freshness follows the Cache-Control and Expires
+// headers sent by TMDb, stale entries with an ETag or Last-Modified header are revalidated with
+// a conditional request. Requests made with a session are never cached.
+func WithCache(store CacheStore) ClientOption {
+	return func(c *Client) {
+		c.cache = store
+	}
+}
+
+// WithCacheTTL overrides how long responses of the endpoints matching pattern stay fresh.
+// Patterns use path.Match syntax against the endpoint path without the API version,
+// e.g. "/configuration" or "/movie/*". The first matching pattern wins.
+func WithCacheTTL(pattern string, ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		c.cacheTTLs = append(c.cacheTTLs, cacheTTL{pattern: pattern, ttl: ttl})
+	}
+}
+
+// fetchCached serves a GET request from the cache when possible and keeps the cache up to date.
+func (c *Client) fetchCached(ctx context.Context, endpoint string, u *url.URL, v url.Values) (*CacheEntry, error) {
+	key := cacheKey(http.MethodGet, u.Path, v)
+	now := time.Now()
+
+	entry, ok := c.cache.Get(key)
+	if ok && entry.Fresh(now) {
+		return entry, nil
+	}
+
+	var header http.Header
+	if ok {
+		header = entry.validators()
+	}
+
+	resp, err := c.do(ctx, http.MethodGet, u, header, nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		if !ok {
+			return nil, &TmdbError{HTTPStatus: resp.StatusCode, Method: http.MethodGet, Path: redactPath(u.Path), StatusMessage: http.StatusText(resp.StatusCode)}
+		}
+
+		revalidated := *entry
+		revalidated.Header = entry.Header.Clone()
+		for _, name := range []string{"Cache-Control", "Expires", "ETag", "Last-Modified", "Date", "Age"} {
+			if value := resp.Header.Get(name); value != "" {
+				revalidated.Header.Set(name, value)
+			}
+		}
+		c.storeCacheEntry(key, endpoint, &revalidated, now)
+		return &revalidated, nil
+	}
+
+	fetched, err := bufferResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusOK {
+		c.storeCacheEntry(key, endpoint, fetched, now)
+	}
+	return fetched, nil
+}
+
+// storeCacheEntry computes the expiry of entry and stores it, or evicts the key when
+// the response may not be cached.
+func (c *Client) storeCacheEntry(key, endpoint string, entry *CacheEntry, now time.Time) {
+	directives := parseCacheControl(entry.Header.Get("Cache-Control"))
+	if _, noStore := directives["no-store"]; noStore {
+		c.cache.Delete(key)
+		return
+	}
+
+	entry.StoredAt = now
+	entry.ExpiresAt = c.cacheExpiry(endpoint, entry.Header, directives, now)
+
+	hasValidator := entry.Header.Get("ETag") != "" || entry.Header.Get("Last-Modified") != ""
+	if !entry.Fresh(now) && !hasValidator {
+		c.cache.Delete(key)
+		return
+	}
+	c.cache.Set(key, entry)
+}
+
+func (c *Client) cacheExpiry(endpoint string, header http.Header, directives map[string]string, now time.Time) time.Time {
+	endpoint = "/" + strings.TrimPrefix(endpoint, "/")
+	for _, override := range c.cacheTTLs {
+		if ok, _ := path.Match(override.pattern, endpoint); ok {
+			return now.Add(override.ttl)
+		}
+	}
+
+	if _, noCache := directives["no-cache"]; noCache {
+		return now
+	}
+
+	if maxAge, ok := directives["max-age"]; ok {
+		if seconds, err := strconv.Atoi(maxAge); err == nil {
+			if age, err := strconv.Atoi(header.Get("Age")); err == nil && age > 0 {
+				seconds -= age
+			}
+			return now.Add(time.Duration(seconds) * time.Second)
+		}
+	}
+
+	if expires := header.Get("Expires"); expires != "" {
+		if t, err := http.ParseTime(expires); err == nil {
+			return t
+		}
+		// invalid dates, e.g. "0", mean already expired
+		return now
+	}
+
+	return now
+}
+
+func parseCacheControl(value string) map[string]string {
+	directives := map[string]string{}
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, arg, _ := strings.Cut(part, "=")
+		directives[strings.ToLower(strings.TrimSpace(name))] = strings.Trim(strings.TrimSpace(arg), `"`)
+	}
+	return directives
+}
+
+// isCacheable reports whether a response to a request with these query params may be shared.
+// Session scoped requests return per user data and are never cached.
+func isCacheable(v url.Values) bool {
+	return !v.Has("session_id") && !v.Has("guest_session_id")
+}
+
+// cacheableEndpoint reports whether responses of endpoint may be cached at all. Authentication
+// responses hand out new tokens and sessions, so they are never cached whatever the headers or
+// TTL overrides say.
+func cacheableEndpoint(endpoint string) bool {
+	return endpoint != "/authentication" && !strings.HasPrefix(endpoint, "/authentication/")
+}
+
+// cacheKey identifies a request by method, path and its sorted query without credentials.
+func cacheKey(method, path string, v url.Values) string {
+	clean := make(url.Values, len(v))
+	for key, values := range v {
+		clean[key] = values
+	}
+	for _, key := range sensitiveParams {
+		clean.Del(key)
+	}
+	return method + " " + path + "?" + clean.Encode()
+}
+
+// LRUCache is an in-memory CacheStore holding up to a fixed number of entries,
+// evicting the least recently used one first.
+type LRUCache struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List
+	items    map[string]*list.Element
+}
+
+type lruItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// NewLRUCache returns an in-memory cache holding up to capacity entries.
+func NewLRUCache(capacity int) *LRUCache {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &LRUCache{
+		capacity: capacity,
+		order:    list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+func (l *LRUCache) Get(key string) (*CacheEntry, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	elem, ok := l.items[key]
+	if !ok {
+		return nil, false
+	}
+	l.order.MoveToFront(elem)
+	return elem.Value.(*lruItem).entry, true
+}
+
+func (l *LRUCache) Set(key string, entry *CacheEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if elem, ok := l.items[key]; ok {
+		elem.Value.(*lruItem).entry = entry
+		l.order.MoveToFront(elem)
+		return
+	}
+
+	l.items[key] = l.order.PushFront(&lruItem{key: key, entry: entry})
+	for l.order.Len() > l.capacity {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.items, oldest.Value.(*lruItem).key)
+	}
+}
+
+func (l *LRUCache) Delete(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if elem, ok := l.items[key]; ok {
+		l.order.Remove(elem)
+		delete(l.items, key)
+	}
+}
+
+// Len returns the number of cached entries.
+func (l *LRUCache) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.order.Len()
+}
@@ -0,0 +1,68 @@
+package tmdb
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// FileCache is a CacheStore keeping one JSON file per entry in a directory,
+// so cached responses survive restarts and can be shared between processes.
+type FileCache struct {
+	dir string
+}
+
+// NewFileCache returns a cache storing its entries in dir, creating it if needed.
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &FileCache{dir: dir}, nil
+}
+
+func (f *FileCache) Get(key string) (*CacheEntry, bool) {
+	data, err := os.ReadFile(f.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	var entry CacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false
+	}
+	return &entry, true
+}
+
+func (f *FileCache) Set(key string, entry *CacheEntry) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	// write to a temp file first so readers never see a partial entry
+	tmp, err := os.CreateTemp(f.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		return
+	}
+	_ = os.Rename(tmp.Name(), f.path(key))
+}
+
+func (f *FileCache) Delete(key string) {
+	_ = os.Remove(f.path(key))
+}
+
+func (f *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(f.dir, hex.EncodeToString(sum[:])+".json")
+}
@@ -0,0 +1,190 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClientCache(t *testing.T) {
+	t.Run("Serves fresh responses from the cache", func(t *testing.T) {
+		var calls int32
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.Header().Set("Cache-Control", "public, max-age=3600")
+			_, _ = w.Write([]byte(`{"genres":[{"id":28,"name":"Action"}]}`))
+		}, WithCache(NewLRUCache(10)))
+		defer testServer.Close()
+
+		for i := 0; i < 3; i++ {
+			result, err := testClient.Genres.GetMovieGenres(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(result.Genres) != 1 {
+				t.Errorf("expected 1 genre, got %d", len(result.Genres))
+			}
+		}
+
+		if calls != 1 {
+			t.Errorf("expected 1 call, got %d", calls)
+		}
+	})
+
+	t.Run("Revalidates stale responses with ETag", func(t *testing.T) {
+		var calls, notModified int32
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				atomic.AddInt32(&notModified, 1)
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("Cache-Control", "no-cache")
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = w.Write([]byte(`{"genres":[{"id":28,"name":"Action"}]}`))
+		}, WithCache(NewLRUCache(10)))
+		defer testServer.Close()
+
+		for i := 0; i < 2; i++ {
+			result, err := testClient.Genres.GetMovieGenres(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(result.Genres) != 1 {
+				t.Errorf("expected 1 genre, got %d", len(result.Genres))
+			}
+		}
+
+		if calls != 2 || notModified != 1 {
+			t.Errorf("expected 2 calls with 1 revalidation, got %d calls and %d revalidations", calls, notModified)
+		}
+	})
+
+	t.Run("TTL override and query aware keys", func(t *testing.T) {
+		var calls int32
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			_, _ = w.Write([]byte(`{"id":550,"title":"Fight Club"}`))
+		}, WithCache(NewLRUCache(10)), WithCacheTTL("/movie/*", time.Hour))
+		defer testServer.Close()
+
+		for i := 0; i < 2; i++ {
+			if _, err := testClient.Movies.GetDetails(context.Background(), 550, SingleQueryParam{"language", "en-US"}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if _, err := testClient.Movies.GetDetails(context.Background(), 550, SingleQueryParam{"language", "de-DE"}); err != nil {
+			t.Fatal(err)
+		}
+
+		if calls != 2 {
+			t.Errorf("expected 2 calls, got %d", calls)
+		}
+	})
+
+	t.Run("Does not store no-store responses", func(t *testing.T) {
+		var calls int32
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.Header().Set("Cache-Control", "no-store")
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = w.Write([]byte(`{"genres":[]}`))
+		}, WithCache(NewLRUCache(10)), WithCacheTTL("/genre/*/list", time.Hour))
+		defer testServer.Close()
+
+		for i := 0; i < 2; i++ {
+			if _, err := testClient.Genres.GetMovieGenres(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if calls != 2 {
+			t.Errorf("expected 2 calls, got %d", calls)
+		}
+	})
+
+	t.Run("Never caches authentication responses", func(t *testing.T) {
+		var calls int32
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			call := atomic.AddInt32(&calls, 1)
+			w.Header().Set("Cache-Control", "max-age=60")
+			_, _ = fmt.Fprintf(w, `{"success":true,"expires_at":"2024-05-15 09:00:00 UTC","request_token":"token-%d"}`, call)
+		}, WithCache(NewLRUCache(10)), WithCacheTTL("/authentication/token/new", time.Hour))
+		defer testServer.Close()
+
+		first, err := testClient.Authentication.CreateRequestToken(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		second, err := testClient.Authentication.CreateRequestToken(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if calls != 2 || first.RequestToken == second.RequestToken {
+			t.Errorf("expected 2 calls with distinct tokens, got %d calls and %s, %s", calls, first.RequestToken, second.RequestToken)
+		}
+	})
+
+	t.Run("File cache", func(t *testing.T) {
+		store, err := NewFileCache(t.TempDir())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var calls int32
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.Header().Set("Cache-Control", "max-age=3600")
+			_, _ = w.Write([]byte(`{"genres":[{"id":28,"name":"Action"}]}`))
+		}
+
+		firstClient, firstServer := newTestClientAndServerWithHandler(handler, WithCache(store))
+		defer firstServer.Close()
+		if _, err := firstClient.Genres.GetMovieGenres(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+
+		// a second client pointing at the same server reuses the entry written to disk
+		secondClient, err := NewClientWithApiKey("other", WithBaseUrl(firstClient.baseUrl), WithCache(store))
+		if err != nil {
+			t.Fatal(err)
+		}
+		result, err := secondClient.Genres.GetMovieGenres(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(result.Genres) != 1 || result.Genres[0].Name != "Action" {
+			t.Errorf("expected cached genres, got %+v", result.Genres)
+		}
+
+		if calls != 1 {
+			t.Errorf("expected 1 call, got %d", calls)
+		}
+	})
+}
+
+func TestLRUCache(t *testing.T) {
+	cache := NewLRUCache(2)
+	cache.Set("a", &CacheEntry{})
+	cache.Set("b", &CacheEntry{})
+	cache.Get("a")
+	cache.Set("c", &CacheEntry{})
+
+	if _, ok := cache.Get("b"); ok {
+		t.Errorf("expected least recently used entry to be evicted")
+	}
+
+	if _, ok := cache.Get("a"); !ok {
+		t.Errorf("expected recently used entry to be kept")
+	}
+
+	if cache.Len() != 2 {
+		t.Errorf("expected 2 entries, got %d", cache.Len())
+	}
+}
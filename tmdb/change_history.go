@@ -0,0 +1,232 @@
+package tmdb
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// ChangeAction tells what happened to a changed value.
+type ChangeAction string
+
+const (
+	ChangeActionAdded   ChangeAction = "added"
+	ChangeActionCreated ChangeAction = "created"
+	ChangeActionUpdated ChangeAction = "updated"
+	ChangeActionDeleted ChangeAction = "deleted"
+)
+
+// Change groups the changes made to one key, such as title or images, of a movie, tv series,
+// season, episode or person. The keys TMDb uses are listed in ConfigurationDetails.ChangeKeys.
+type Change struct {
+	Key   string       `json:"key"`
+	Items []ChangeItem `json:"items"`
+}
+
+// ChangeItem is a single change. Value and OriginalValue are decoded according to the key
+// of the change, see changeValues for the types used:
+//
+//	for _, item := range change.Items {
+//		switch value := item.Value.(type) {
+//		case string:
+//			fmt.Println(change.Key, "is now", value)
+//		case tmdb.ChangeCredit:
+//			fmt.Println(change.Key, item.Action, value.PersonID)
+//		}
+//	}
+//
+// They are nil when TMDb sent none, e.g. there is no original value for added items, and
+// hold the json.RawMessage as sent by TMDb for keys the client has no type for.
+type ChangeItem struct {
+	ID            string       `json:"id"`
+	Action        ChangeAction `json:"action"`
+	Time          string       `json:"time"`
+	Iso_639_1     string       `json:"iso_639_1"`
+	Iso_3166_1    string       `json:"iso_3166_1"`
+	Value         interface{}  `json:"value,omitempty"`
+	OriginalValue interface{}  `json:"original_value,omitempty"`
+}
+
+// ChangeImage is the value of an images change.
+type ChangeImage struct {
+	FilePath  string `json:"file_path"`
+	Iso_639_1 string `json:"iso_639_1"`
+}
+
+// ChangeImages is the value of an images change, keyed by the kind of image, e.g. poster or backdrop.
+type ChangeImages map[string]ChangeImage
+
+// ChangeCredit is the value of a cast, crew, guest_stars or created_by change.
+type ChangeCredit struct {
+	CastID     int32  `json:"cast_id,omitempty"`
+	Character  string `json:"character,omitempty"`
+	CreditID   string `json:"credit_id"`
+	Department string `json:"department,omitempty"`
+	Job        string `json:"job,omitempty"`
+	Order      int32  `json:"order,omitempty"`
+	PersonID   int32  `json:"person_id"`
+}
+
+// ChangeEntity is the value of changes referencing another TMDb entity, such as genres,
+// production_companies, network or plot_keywords.
+type ChangeEntity struct {
+	ID   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+// ChangeAlternativeTitle is the value of an alternative_titles change.
+type ChangeAlternativeTitle struct {
+	Iso_3166_1 string `json:"iso_3166_1"`
+	Title      string `json:"title"`
+	Type       string `json:"type"`
+}
+
+// ChangeRelease is the value of a releases change.
+type ChangeRelease struct {
+	Certification string `json:"certification"`
+	Iso_639_1     string `json:"iso_639_1"`
+	Note          string `json:"note"`
+	Primary       bool   `json:"primary"`
+	ReleaseDate   string `json:"release_date"`
+	Type          int32  `json:"type"`
+}
+
+// ChangeVideo is the value of a videos change.
+type ChangeVideo struct {
+	ID        string `json:"id"`
+	Iso_639_1 string `json:"iso_639_1"`
+	Key       string `json:"key"`
+	Name      string `json:"name"`
+	Site      string `json:"site"`
+	Size      int32  `json:"size"`
+	Type      string `json:"type"`
+}
+
+// ChangeSeason is the value of a season change of a tv series.
+type ChangeSeason struct {
+	SeasonID     int32 `json:"season_id"`
+	SeasonNumber int32 `json:"season_number"`
+}
+
+// ChangeEpisode is the value of an episode change of a tv season.
+type ChangeEpisode struct {
+	EpisodeID     int32 `json:"episode_id"`
+	EpisodeNumber int32 `json:"episode_number"`
+}
+
+// changeValues decodes the value of a change by its key.
+var changeValues = map[string]func(json.RawMessage) (interface{}, error){
+	"adult":                changeValue[bool],
+	"air_date":             changeValue[string],
+	"also_known_as":        changeValue[string],
+	"alternative_titles":   changeValue[ChangeAlternativeTitle],
+	"biography":            changeValue[string],
+	"birthday":             changeValue[string],
+	"budget":               changeValue[int64],
+	"cast":                 changeValue[ChangeCredit],
+	"created_by":           changeValue[ChangeCredit],
+	"crew":                 changeValue[ChangeCredit],
+	"deathday":             changeValue[string],
+	"episode":              changeValue[ChangeEpisode],
+	"episode_number":       changeValue[int32],
+	"episode_run_time":     changeValue[int32],
+	"gender":               changeValue[int32],
+	"genres":               changeValue[ChangeEntity],
+	"guest_stars":          changeValue[ChangeCredit],
+	"homepage":             changeValue[string],
+	"images":               changeValue[ChangeImages],
+	"imdb_id":              changeValue[string],
+	"known_for_department": changeValue[string],
+	"languages":            changeValue[string],
+	"name":                 changeValue[string],
+	"network":              changeValue[ChangeEntity],
+	"origin_country":       changeValue[string],
+	"original_language":    changeValue[string],
+	"original_name":        changeValue[string],
+	"original_title":       changeValue[string],
+	"overview":             changeValue[string],
+	"place_of_birth":       changeValue[string],
+	"plot_keywords":        changeValue[ChangeEntity],
+	"production_code":      changeValue[string],
+	"production_companies": changeValue[ChangeEntity],
+	"production_countries": changeValue[string],
+	"releases":             changeValue[ChangeRelease],
+	"revenue":              changeValue[int64],
+	"runtime":              changeValue[int32],
+	"season":               changeValue[ChangeSeason],
+	"season_number":        changeValue[int32],
+	"spoken_languages":     changeValue[string],
+	"status":               changeValue[string],
+	"tagline":              changeValue[string],
+	"title":                changeValue[string],
+	"tvdb_id":              changeValue[int32],
+	"type":                 changeValue[string],
+	"video":                changeValue[bool],
+	"videos":               changeValue[ChangeVideo],
+}
+
+func changeValue[T any](raw json.RawMessage) (interface{}, error) {
+	var value T
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// UntypedChangeKeys returns the change keys of the configuration the client decodes as json.RawMessage.
+func (cd *ConfigurationDetails) UntypedChangeKeys() []string {
+	var keys []string
+	for _, key := range cd.ChangeKeys {
+		if _, ok := changeValues[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func (c *Change) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Key   string `json:"key"`
+		Items []struct {
+			ID            string          `json:"id"`
+			Action        ChangeAction    `json:"action"`
+			Time          string          `json:"time"`
+			Iso_639_1     string          `json:"iso_639_1"`
+			Iso_3166_1    string          `json:"iso_3166_1"`
+			Value         json.RawMessage `json:"value"`
+			OriginalValue json.RawMessage `json:"original_value"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	c.Key = raw.Key
+	c.Items = make([]ChangeItem, len(raw.Items))
+	for i, item := range raw.Items {
+		c.Items[i] = ChangeItem{
+			ID:            item.ID,
+			Action:        item.Action,
+			Time:          item.Time,
+			Iso_639_1:     item.Iso_639_1,
+			Iso_3166_1:    item.Iso_3166_1,
+			Value:         decodeChangeValue(raw.Key, item.Value),
+			OriginalValue: decodeChangeValue(raw.Key, item.OriginalValue),
+		}
+	}
+	return nil
+}
+
+// decodeChangeValue decodes raw by key. TMDb is not strict about the shape of change values,
+// so values not matching the type of their key are kept as json.RawMessage rather than failing
+// the whole response.
+func decodeChangeValue(key string, raw json.RawMessage) interface{} {
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return nil
+	}
+	if decode, ok := changeValues[key]; ok {
+		if value, err := decode(raw); err == nil {
+			return value
+		}
+	}
+	return raw
+}
@@ -3,30 +3,57 @@ package tmdb
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"time"
 )
 
+// maxChangesWindow is the longest start_date to end_date range the change feeds accept.
+const maxChangesWindow = 14 * 24 * time.Hour
+
 type ChangesService interface {
-	GetMovieChanges(ctx context.Context, queryParams ...queryParam) (*Changes, error)
-	GetTVChanges(ctx context.Context, queryParams ...queryParam) (*Changes, error)
-	GetPersonChanges(ctx context.Context, queryParams ...queryParam) (*Changes, error)
+	GetMovieChanges(ctx context.Context, queryParams ...QueryParam) (*Changes, error)
+	GetTVChanges(ctx context.Context, queryParams ...QueryParam) (*Changes, error)
+	GetPersonChanges(ctx context.Context, queryParams ...QueryParam) (*Changes, error)
+	GetMovieChangesBetween(ctx context.Context, from, to time.Time, queryParams ...QueryParam) ([]ChangedEntity, error)
+	GetTVChangesBetween(ctx context.Context, from, to time.Time, queryParams ...QueryParam) ([]ChangedEntity, error)
+	GetPersonChangesBetween(ctx context.Context, from, to time.Time, queryParams ...QueryParam) ([]ChangedEntity, error)
 }
 
 type ChangesClient struct {
 	baseClient *Client
 }
 
+type ChangedItem struct {
+	ID    int32 `json:"id"`
+	Adult bool  `json:"adult"`
+}
+
 type Changes struct {
-	Changes []struct {
-		ID    string `json:"id"`
-		Adult bool   `json:"adult"`
-	} `json:"changes"`
-	Page         int `json:"page"`
-	TotalPages   int `json:"total_pages"`
-	TotalResults int `json:"total_results"`
+	Changes      []ChangedItem `json:"changes"`
+	Page         int           `json:"page"`
+	TotalPages   int           `json:"total_pages"`
+	TotalResults int           `json:"total_results"`
+}
+
+func (r *Changes) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *Changes) results() []ChangedItem {
+	return r.Changes
+}
+
+// ChangedEntity is an entity that changed within the range given to one of the Get*ChangesBetween methods.
+type ChangedEntity struct {
+	ID    int32
+	Adult bool
+	// ChangedAt is the end of the last window of at most 14 days the entity changed in,
+	// so its latest change happened on or before it.
+	ChangedAt time.Time
 }
 
-func (cc *ChangesClient) GetMovieChanges(ctx context.Context, queryParams ...queryParam) (*Changes, error) {
+func (cc *ChangesClient) GetMovieChanges(ctx context.Context, queryParams ...QueryParam) (*Changes, error) {
 	reps, err := cc.baseClient.request(ctx, http.MethodGet, "/movie/changes", queryParams...)
 	if err != nil {
 		return nil, err
@@ -40,7 +67,7 @@ func (cc *ChangesClient) GetMovieChanges(ctx context.Context, queryParams ...que
 	return &result, nil
 }
 
-func (cc *ChangesClient) GetTVChanges(ctx context.Context, queryParams ...queryParam) (*Changes, error) {
+func (cc *ChangesClient) GetTVChanges(ctx context.Context, queryParams ...QueryParam) (*Changes, error) {
 	reps, err := cc.baseClient.request(ctx, http.MethodGet, "/tv/changes", queryParams...)
 	if err != nil {
 		return nil, err
@@ -54,7 +81,7 @@ func (cc *ChangesClient) GetTVChanges(ctx context.Context, queryParams ...queryP
 	return &result, nil
 }
 
-func (cc *ChangesClient) GetPersonChanges(ctx context.Context, queryParams ...queryParam) (*Changes, error) {
+func (cc *ChangesClient) GetPersonChanges(ctx context.Context, queryParams ...QueryParam) (*Changes, error) {
 	reps, err := cc.baseClient.request(ctx, http.MethodGet, "/person/changes", queryParams...)
 	if err != nil {
 		return nil, err
@@ -67,3 +94,61 @@ func (cc *ChangesClient) GetPersonChanges(ctx context.Context, queryParams ...qu
 	}
 	return &result, nil
 }
+
+// GetMovieChangesBetween returns the movies changed between from and to, see changesBetween.
+func (cc *ChangesClient) GetMovieChangesBetween(ctx context.Context, from, to time.Time, queryParams ...QueryParam) ([]ChangedEntity, error) {
+	return changesBetween(ctx, cc.GetMovieChanges, from, to, queryParams)
+}
+
+// GetTVChangesBetween returns the tv series changed between from and to, see changesBetween.
+func (cc *ChangesClient) GetTVChangesBetween(ctx context.Context, from, to time.Time, queryParams ...QueryParam) ([]ChangedEntity, error) {
+	return changesBetween(ctx, cc.GetTVChanges, from, to, queryParams)
+}
+
+// GetPersonChangesBetween returns the people changed between from and to, see changesBetween.
+func (cc *ChangesClient) GetPersonChangesBetween(ctx context.Context, from, to time.Time, queryParams ...QueryParam) ([]ChangedEntity, error) {
+	return changesBetween(ctx, cc.GetPersonChanges, from, to, queryParams)
+}
+
+// changesBetween splits the range from to to into windows of at most 14 days, the longest the
+// change feeds accept, and walks every page of each. Entities are returned once, in the order
+// they first changed, with the end of the last window they showed up in.
+func changesBetween(ctx context.Context, fetch func(context.Context, ...QueryParam) (*Changes, error), from, to time.Time, queryParams []QueryParam) ([]ChangedEntity, error) {
+	if to.Before(from) {
+		return nil, fmt.Errorf("%w: changes range starts after it ends", ErrInvalidQueryParams)
+	}
+
+	var entities []ChangedEntity
+	indexes := map[int32]int{}
+	for start := from; ; {
+		end := start.Add(maxChangesWindow)
+		if end.After(to) {
+			end = to
+		}
+
+		// the window is appended last, so it wins over a start_date or end_date in queryParams
+		params := append(append([]QueryParam(nil), queryParams...),
+			SingleQueryParam{Key: "start_date", Value: start.UTC().Format(time.DateOnly)},
+			SingleQueryParam{Key: "end_date", Value: end.UTC().Format(time.DateOnly)},
+		)
+		items, err := NewPager(fetch, PagerOptions{}, params...).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			if i, ok := indexes[item.ID]; ok {
+				entities[i].Adult = item.Adult
+				entities[i].ChangedAt = end
+				continue
+			}
+			indexes[item.ID] = len(entities)
+			entities = append(entities, ChangedEntity{ID: item.ID, Adult: item.Adult, ChangedAt: end})
+		}
+
+		if !end.Before(to) {
+			return entities, nil
+		}
+		start = end
+	}
+}
@@ -0,0 +1,54 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestChangesClient(t *testing.T) {
+	t.Run("Get Movie Changes Between Splits Long Ranges", func(t *testing.T) {
+		var windows []string
+		testClient, closeServer := newChangesServer(&windows)
+		defer closeServer()
+
+		from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := from.AddDate(0, 0, 31)
+		result, err := testClient.Changes.GetMovieChangesBetween(context.Background(), from, to, SingleQueryParam{"start_date", "2023-01-01"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expectedWindows := "2024-01-01/2024-01-15,2024-01-15/2024-01-29,2024-01-29/2024-02-01"
+		if strings.Join(windows, ",") != expectedWindows {
+			t.Errorf("expected windows %s, got %s", expectedWindows, strings.Join(windows, ","))
+		}
+
+		if len(result) != 4 {
+			t.Fatalf("expected 4 changed movies, got %d", len(result))
+		}
+
+		for i, entity := range result {
+			if entity.ID != int32(i+1) || !entity.ChangedAt.Equal(to) {
+				t.Errorf("expected movie %d changed at %s, got %+v", i+1, to, entity)
+			}
+		}
+	})
+
+	t.Run("Get Movie Changes Between Rejects Reversed Ranges", func(t *testing.T) {
+		var windows []string
+		testClient, closeServer := newChangesServer(&windows)
+		defer closeServer()
+
+		now := time.Now()
+		if _, err := testClient.Changes.GetMovieChangesBetween(context.Background(), now, now.Add(-time.Hour)); !errors.Is(err, ErrInvalidQueryParams) {
+			t.Errorf("expected ErrInvalidQueryParams, got %v", err)
+		}
+
+		if len(windows) != 0 {
+			t.Errorf("expected no request to be sent, got %d", len(windows))
+		}
+	})
+}
@@ -8,8 +8,8 @@ import (
 )
 
 type CollectionsService interface {
-	GetDetails(ctx context.Context, collectionId int32, queryParams ...queryParam) (*Collection, error)
-	GetImages(ctx context.Context, collectionId int32, queryParams ...queryParam) (*CollectionImages, error)
+	GetDetails(ctx context.Context, collectionId int32, queryParams ...QueryParam) (*Collection, error)
+	GetImages(ctx context.Context, collectionId int32, queryParams ...QueryParam) (*CollectionImages, error)
 	GetTranslations(ctx context.Context, collectionId int32) (*CollectionTranslations, error)
 }
 
@@ -83,7 +83,7 @@ type CollectionTranslations struct {
 	} `json:"translations"`
 }
 
-func (cc *CollectionsClient) GetDetails(ctx context.Context, collectionId int32, queryParams ...queryParam) (*Collection, error) {
+func (cc *CollectionsClient) GetDetails(ctx context.Context, collectionId int32, queryParams ...QueryParam) (*Collection, error) {
 	resp, err := cc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/collection/%d", collectionId), queryParams...)
 	if err != nil {
 		return nil, err
@@ -97,7 +97,7 @@ func (cc *CollectionsClient) GetDetails(ctx context.Context, collectionId int32,
 	return &result, nil
 }
 
-func (cc *CollectionsClient) GetImages(ctx context.Context, collectionId int32, queryParams ...queryParam) (*CollectionImages, error) {
+func (cc *CollectionsClient) GetImages(ctx context.Context, collectionId int32, queryParams ...QueryParam) (*CollectionImages, error) {
 	resp, err := cc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/collection/%d/images", collectionId), queryParams...)
 	if err != nil {
 		return nil, err
@@ -8,7 +8,7 @@ import (
 
 type ConfigurationsService interface {
 	GetDetails(ctx context.Context) (*ConfigurationDetails, error)
-	GetCountries(ctx context.Context, queryParams ...queryParam) (*ConfigurationCountries, error)
+	GetCountries(ctx context.Context, queryParams ...QueryParam) (*ConfigurationCountries, error)
 	GetJobs(ctx context.Context) (*ConfigurationJobs, error)
 	GetLanguages(ctx context.Context) (*ConfigurationLanguages, error)
 	GetPrimaryTranslations(ctx context.Context) (*ConfigurationPrimaryTranslations, error)
@@ -78,7 +78,7 @@ func (cc *ConfigurationsClient) GetDetails(ctx context.Context) (*ConfigurationD
 	return &result, nil
 }
 
-func (cc *ConfigurationsClient) GetCountries(ctx context.Context, queryParams ...queryParam) (*ConfigurationCountries, error) {
+func (cc *ConfigurationsClient) GetCountries(ctx context.Context, queryParams ...QueryParam) (*ConfigurationCountries, error) {
 	resp, err := cc.baseClient.request(ctx, http.MethodGet, "/configuration/countries", queryParams...)
 	if err != nil {
 		return nil, err
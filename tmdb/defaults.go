@@ -0,0 +1,99 @@
+package tmdb
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// WithDefaultLanguage sends language, e.g. "de-DE", to every endpoint accepting it,
+// unless the call sets a language itself.
+func WithDefaultLanguage(language string) ClientOption {
+	return func(c *Client) {
+		c.setDefaultParam("language", language)
+	}
+}
+
+// WithDefaultRegion sends region, an ISO 3166-1 code like "DE", to every endpoint accepting it,
+// unless the call sets a region itself.
+func WithDefaultRegion(region string) ClientOption {
+	return func(c *Client) {
+		c.setDefaultParam("region", region)
+	}
+}
+
+// WithIncludeAdult sets include_adult on every endpoint accepting it, unless the call sets it itself.
+// By default the param is left out, which TMDb treats as false.
+func WithIncludeAdult(include bool) ClientOption {
+	return func(c *Client) {
+		c.setDefaultParam("include_adult", strconv.FormatBool(include))
+	}
+}
+
+// setDefaultParam sets or, for an empty value, removes a default param.
+func (c *Client) setDefaultParam(key, value string) {
+	if c.defaultParams == nil {
+		c.defaultParams = url.Values{}
+	}
+	if value == "" {
+		c.defaultParams.Del(key)
+		return
+	}
+	c.defaultParams.Set(key, value)
+}
+
+// applyDefaults adds the default params endpoint accepts and the call did not set.
+func (c *Client) applyDefaults(endpoint string, v url.Values) {
+	for key, values := range c.defaultParams {
+		if !v.Has(key) && c.accepts(endpoint, key) {
+			v[key] = values
+		}
+	}
+}
+
+// accepts reports whether endpoint takes the query param key according to its schema.
+func (c *Client) accepts(endpoint, key string) bool {
+	schema, ok := schemaFor(endpoint)
+	if !ok {
+		return false
+	}
+	if _, ok := schema[key]; ok {
+		return true
+	}
+	return c.isExtraParam(endpoint, key)
+}
+
+// WithLanguage returns a client using language as its default language.
+func (c *Client) WithLanguage(language string) *Client {
+	return c.derive(WithDefaultLanguage(language))
+}
+
+// WithRegion returns a client using region as its default region.
+func (c *Client) WithRegion(region string) *Client {
+	return c.derive(WithDefaultRegion(region))
+}
+
+// WithSession returns a client sending sessionID, e.g. from Authentication.CreateSession,
+// to every endpoint accepting it, such as account states, ratings and the Accounts methods.
+func (c *Client) WithSession(sessionID string) *Client {
+	return c.derive(func(c *Client) {
+		c.setDefaultParam("session_id", sessionID)
+	})
+}
+
+// derive returns a copy of c with opts applied. Options must only change per client settings,
+// anything shared is carried over as is: derived clients share the HTTP client, middlewares,
+// rate limiter, cache and coalesced requests of c.
+func (c *Client) derive(opts ...ClientOption) *Client {
+	derived := *c
+	derived.defaultParams = make(url.Values, len(c.defaultParams))
+	for key, values := range c.defaultParams {
+		derived.defaultParams[key] = append([]string(nil), values...)
+	}
+
+	for _, opt := range opts {
+		opt(&derived)
+	}
+
+	derived.initServices()
+	return &derived
+}
@@ -0,0 +1,97 @@
+package tmdb
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClientDefaults(t *testing.T) {
+	t.Run("Applies defaults where accepted", func(t *testing.T) {
+		queries := map[string]url.Values{}
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			queries[r.URL.Path] = r.URL.Query()
+			_, _ = w.Write([]byte(`{}`))
+		}, WithDefaultLanguage("de-DE"), WithDefaultRegion("DE"), WithIncludeAdult(false))
+		defer testServer.Close()
+
+		ctx := context.Background()
+		if _, err := testClient.Search.GetMovie(ctx, SingleQueryParam{"query", "tokyo"}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := testClient.MovieLists.GetPopular(ctx, SingleQueryParam{"language", "ja-JP"}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := testClient.Movies.GetDetails(ctx, 18148); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := testClient.Movies.GetCredits(ctx, 18148, SingleQueryParam{"language", "fr-FR"}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := testClient.Movies.GetImages(ctx, 18148, SingleQueryParam{"include_image_language", "en,null"}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := testClient.Configuration.GetDetails(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		expected := map[string]string{
+			"/3/search/movie":        "include_adult=false&language=de-DE&query=tokyo&region=DE",
+			"/3/movie/popular":       "language=ja-JP&region=DE",
+			"/3/movie/18148":         "language=de-DE",
+			"/3/movie/18148/credits": "language=fr-FR",
+			"/3/movie/18148/images":  "include_image_language=en%2Cnull&language=de-DE",
+			"/3/configuration":       "",
+		}
+		for path, query := range expected {
+			if queries[path].Encode() != query {
+				t.Errorf("expected %s?%s, got %q", path, query, queries[path].Encode())
+			}
+		}
+	})
+
+	t.Run("Derived clients share the transport and cache", func(t *testing.T) {
+		var calls, middlewareCalls int32
+		languages := make(chan string, 3)
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			languages <- r.URL.Query().Get("language")
+			_, _ = w.Write([]byte(`{"genres":[]}`))
+		},
+			WithDefaultLanguage("en-US"),
+			WithCache(NewLRUCache(10)),
+			WithCacheTTL("/genre/*/list", time.Hour),
+			WithMiddleware(func(next Doer) Doer {
+				return DoerFunc(func(req *http.Request) (*http.Response, error) {
+					atomic.AddInt32(&middlewareCalls, 1)
+					return next.Do(req)
+				})
+			}),
+		)
+		defer testServer.Close()
+
+		french := testClient.WithLanguage("fr-FR")
+		ctx := context.Background()
+		for _, client := range []*Client{testClient, french, french.WithRegion("FR"), testClient} {
+			if _, err := client.Genres.GetMovieGenres(ctx); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// the client with a region reuses the cached french response, since genres take no region
+		if calls != 2 || middlewareCalls != 2 {
+			t.Errorf("expected 2 calls through the shared middleware, got %d calls and %d middleware calls", calls, middlewareCalls)
+		}
+
+		if first, second := <-languages, <-languages; first != "en-US" || second != "fr-FR" {
+			t.Errorf("expected en-US then fr-FR, got %s then %s", first, second)
+		}
+
+		if testClient.defaultParams.Get("language") != "en-US" {
+			t.Errorf("expected the parent client to keep its language, got %s", testClient.defaultParams.Get("language"))
+		}
+	})
+}
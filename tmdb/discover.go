@@ -7,58 +7,78 @@ import (
 )
 
 type DiscoverService interface {
-	GetMovies(ctx context.Context, queryParams ...queryParam) (*DiscoverMoviesResponse, error)
-	GetTVShows(ctx context.Context, queryParams ...queryParam) (*DiscoverTVShowsResponse, error)
+	GetMovies(ctx context.Context, queryParams ...QueryParam) (*DiscoverMoviesResponse, error)
+	GetTVShows(ctx context.Context, queryParams ...QueryParam) (*DiscoverTVShowsResponse, error)
 }
 
 type DiscoverClient struct {
 	baseClient *Client
 }
 
+type DiscoverMovie struct {
+	Adult            bool    `json:"adult"`
+	BackdropPath     string  `json:"backdrop_path"`
+	GenreIds         []int32 `json:"genre_ids"`
+	ID               int32   `json:"id"`
+	OriginalLanguage string  `json:"original_language"`
+	OriginalTitle    string  `json:"original_title"`
+	Overview         string  `json:"overview"`
+	Popularity       float64 `json:"popularity"`
+	PosterPath       string  `json:"poster_path"`
+	ReleaseDate      string  `json:"release_date"`
+	Title            string  `json:"title"`
+	Video            bool    `json:"video"`
+	VoteAverage      float64 `json:"vote_average"`
+	VoteCount        int32   `json:"vote_count"`
+}
+
 type DiscoverMoviesResponse struct {
-	Page         int `json:"page"`
-	TotalPages   int `json:"total_pages"`
-	TotalResults int `json:"total_results"`
-	Results      []struct {
-		Adult            bool    `json:"adult"`
-		BackdropPath     string  `json:"backdrop_path"`
-		GenreIds         []int32 `json:"genre_ids"`
-		ID               int32   `json:"id"`
-		OriginalLanguage string  `json:"original_language"`
-		OriginalTitle    string  `json:"original_title"`
-		Overview         string  `json:"overview"`
-		Popularity       float64 `json:"popularity"`
-		PosterPath       string  `json:"poster_path"`
-		ReleaseDate      string  `json:"release_date"`
-		Title            string  `json:"title"`
-		Video            bool    `json:"video"`
-		VoteAverage      float64 `json:"vote_average"`
-		VoteCount        int32   `json:"vote_count"`
-	} `json:"results"`
+	Page         int             `json:"page"`
+	TotalPages   int             `json:"total_pages"`
+	TotalResults int             `json:"total_results"`
+	Results      []DiscoverMovie `json:"results"`
+}
+
+func (r *DiscoverMoviesResponse) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *DiscoverMoviesResponse) results() []DiscoverMovie {
+	return r.Results
+}
+
+type DiscoverTVShow struct {
+	BackdropPath     string   `json:"backdrop_path"`
+	FirstAirDate     string   `json:"first_air_date"`
+	GenreIds         []int32  `json:"genre_ids"`
+	ID               int32    `json:"id"`
+	Name             string   `json:"name"`
+	OriginCountry    []string `json:"origin_country"`
+	OriginalLanguage string   `json:"original_language"`
+	OriginalName     string   `json:"original_name"`
+	Overview         string   `json:"overview"`
+	Popularity       float64  `json:"popularity"`
+	PosterPath       string   `json:"poster_path"`
+	VoteAverage      float64  `json:"vote_average"`
+	VoteCount        int32    `json:"vote_count"`
 }
 
 type DiscoverTVShowsResponse struct {
-	Page         int `json:"page"`
-	TotalPages   int `json:"total_pages"`
-	TotalResults int `json:"total_results"`
-	Results      []struct {
-		BackdropPath     string   `json:"backdrop_path"`
-		FirstAirDate     string   `json:"first_air_date"`
-		GenreIds         []int32  `json:"genre_ids"`
-		ID               int32    `json:"id"`
-		Name             string   `json:"name"`
-		OriginCountry    []string `json:"origin_country"`
-		OriginalLanguage string   `json:"original_language"`
-		OriginalName     string   `json:"original_name"`
-		Overview         string   `json:"overview"`
-		Popularity       float64  `json:"popularity"`
-		PosterPath       string   `json:"poster_path"`
-		VoteAverage      float64  `json:"vote_average"`
-		VoteCount        int32    `json:"vote_count"`
-	} `json:"results"`
+	Page         int              `json:"page"`
+	TotalPages   int              `json:"total_pages"`
+	TotalResults int              `json:"total_results"`
+	Results      []DiscoverTVShow `json:"results"`
+}
+
+func (r *DiscoverTVShowsResponse) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *DiscoverTVShowsResponse) results() []DiscoverTVShow {
+	return r.Results
 }
 
-func (dc *DiscoverClient) GetMovies(ctx context.Context, queryParams ...queryParam) (*DiscoverMoviesResponse, error) {
+func (dc *DiscoverClient) GetMovies(ctx context.Context, queryParams ...QueryParam) (*DiscoverMoviesResponse, error) {
 	reps, err := dc.baseClient.request(ctx, http.MethodGet, "/discover/movie", queryParams...)
 	if err != nil {
 		return nil, err
@@ -72,7 +92,7 @@ func (dc *DiscoverClient) GetMovies(ctx context.Context, queryParams ...queryPar
 	return &result, nil
 }
 
-func (dc *DiscoverClient) GetTVShows(ctx context.Context, queryParams ...queryParam) (*DiscoverTVShowsResponse, error) {
+func (dc *DiscoverClient) GetTVShows(ctx context.Context, queryParams ...QueryParam) (*DiscoverTVShowsResponse, error) {
 	reps, err := dc.baseClient.request(ctx, http.MethodGet, "/discover/tv", queryParams...)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,488 @@
+package tmdb
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SortBy orders the results of the discover endpoints.
+type SortBy string
+
+const (
+	SortByPopularityAsc          SortBy = "popularity.asc"
+	SortByPopularityDesc         SortBy = "popularity.desc"
+	SortByVoteAverageAsc         SortBy = "vote_average.asc"
+	SortByVoteAverageDesc        SortBy = "vote_average.desc"
+	SortByVoteCountAsc           SortBy = "vote_count.asc"
+	SortByVoteCountDesc          SortBy = "vote_count.desc"
+	SortByOriginalTitleAsc       SortBy = "original_title.asc"
+	SortByOriginalTitleDesc      SortBy = "original_title.desc"
+	SortByTitleAsc               SortBy = "title.asc"
+	SortByTitleDesc              SortBy = "title.desc"
+	SortByRevenueAsc             SortBy = "revenue.asc"
+	SortByRevenueDesc            SortBy = "revenue.desc"
+	SortByPrimaryReleaseDateAsc  SortBy = "primary_release_date.asc"
+	SortByPrimaryReleaseDateDesc SortBy = "primary_release_date.desc"
+	SortByNameAsc                SortBy = "name.asc"
+	SortByNameDesc               SortBy = "name.desc"
+	SortByOriginalNameAsc        SortBy = "original_name.asc"
+	SortByOriginalNameDesc       SortBy = "original_name.desc"
+	SortByFirstAirDateAsc        SortBy = "first_air_date.asc"
+	SortByFirstAirDateDesc       SortBy = "first_air_date.desc"
+)
+
+var (
+	movieSortBys = []SortBy{
+		SortByPopularityAsc, SortByPopularityDesc,
+		SortByVoteAverageAsc, SortByVoteAverageDesc,
+		SortByVoteCountAsc, SortByVoteCountDesc,
+		SortByOriginalTitleAsc, SortByOriginalTitleDesc,
+		SortByTitleAsc, SortByTitleDesc,
+		SortByRevenueAsc, SortByRevenueDesc,
+		SortByPrimaryReleaseDateAsc, SortByPrimaryReleaseDateDesc,
+	}
+	tvSortBys = []SortBy{
+		SortByPopularityAsc, SortByPopularityDesc,
+		SortByVoteAverageAsc, SortByVoteAverageDesc,
+		SortByVoteCountAsc, SortByVoteCountDesc,
+		SortByNameAsc, SortByNameDesc,
+		SortByOriginalNameAsc, SortByOriginalNameDesc,
+		SortByFirstAirDateAsc, SortByFirstAirDateDesc,
+	}
+)
+
+// MonetizationType is the way a watch provider offers a title.
+type MonetizationType string
+
+const (
+	MonetizationFlatrate MonetizationType = "flatrate"
+	MonetizationFree     MonetizationType = "free"
+	MonetizationAds      MonetizationType = "ads"
+	MonetizationRent     MonetizationType = "rent"
+	MonetizationBuy      MonetizationType = "buy"
+)
+
+// Movie release types, for DiscoverMovieOptions.WithReleaseType.
+const (
+	ReleaseTypePremiere = iota + 1
+	ReleaseTypeTheatricalLimited
+	ReleaseTypeTheatrical
+	ReleaseTypeDigital
+	ReleaseTypePhysical
+	ReleaseTypeTV
+)
+
+// TV series statuses, for DiscoverTVOptions.WithStatus.
+const (
+	TVStatusReturningSeries = iota
+	TVStatusPlanned
+	TVStatusInProduction
+	TVStatusEnded
+	TVStatusCanceled
+	TVStatusPilot
+)
+
+// TV series types, for DiscoverTVOptions.WithType.
+const (
+	TVTypeDocumentary = iota
+	TVTypeNews
+	TVTypeMiniseries
+	TVTypeReality
+	TVTypeScripted
+	TVTypeTalkShow
+	TVTypeVideo
+)
+
+// IDFilter matches titles linked to all (And) or any (Or) of a set of IDs, e.g. genres or keywords.
+type IDFilter struct {
+	IDs []int
+	Any bool
+}
+
+// And matches titles linked to all of ids.
+func And(ids ...int) IDFilter {
+	return IDFilter{IDs: ids}
+}
+
+// Or matches titles linked to any of ids.
+func Or(ids ...int) IDFilter {
+	return IDFilter{IDs: ids, Any: true}
+}
+
+// String encodes the filter the way TMDb expects it: comma separated for AND, pipe separated for OR.
+func (f IDFilter) String() string {
+	sep := ","
+	if f.Any {
+		sep = "|"
+	}
+	return joinInts(f.IDs, sep)
+}
+
+// DateRange limits a date to [From, To]. A zero bound leaves that side open.
+type DateRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// Range limits a number to [Min, Max]. A zero bound leaves that side open.
+type Range struct {
+	Min float64
+	Max float64
+}
+
+// DiscoverMovieOptions are the filters of Discover.GetMovies. Zero fields are left out of the request.
+// See https://developer.themoviedb.org/reference/discover-movie
+type DiscoverMovieOptions struct {
+	SortBy   SortBy
+	Page     int
+	Language string
+	Region   string
+
+	IncludeAdult bool
+	IncludeVideo bool
+
+	// Certification filters require CertificationCountry.
+	Certification        string
+	CertificationGte     string
+	CertificationLte     string
+	CertificationCountry string
+
+	Year               int
+	PrimaryReleaseYear int
+	PrimaryReleaseDate DateRange
+	ReleaseDate        DateRange
+	// WithReleaseType takes the ReleaseType constants and is usually combined with Region.
+	WithReleaseType IDFilter
+
+	VoteAverage Range
+	VoteCount   Range
+	// WithRuntime is in minutes.
+	WithRuntime Range
+
+	WithCast      IDFilter
+	WithCrew      IDFilter
+	WithPeople    IDFilter
+	WithCompanies IDFilter
+	WithGenres    IDFilter
+	WithKeywords  IDFilter
+
+	WithOriginCountry    string
+	WithOriginalLanguage string
+
+	WithoutCompanies []int
+	WithoutGenres    []int
+	WithoutKeywords  []int
+
+	// Watch provider filters require WatchRegion.
+	WatchRegion                string
+	WithWatchProviders         IDFilter
+	WithWatchMonetizationTypes []MonetizationType
+	WithoutWatchProviders      []int
+}
+
+// DiscoverTVOptions are the filters of Discover.GetTVShows. Zero fields are left out of the request.
+// See https://developer.themoviedb.org/reference/discover-tv
+type DiscoverTVOptions struct {
+	SortBy   SortBy
+	Page     int
+	Language string
+	Timezone string
+
+	IncludeAdult             bool
+	IncludeNullFirstAirDates bool
+	ScreenedTheatrically     bool
+
+	FirstAirDateYear int
+	FirstAirDate     DateRange
+	AirDate          DateRange
+
+	VoteAverage Range
+	VoteCount   Range
+	// WithRuntime is in minutes.
+	WithRuntime Range
+
+	WithCompanies IDFilter
+	WithGenres    IDFilter
+	WithKeywords  IDFilter
+	WithNetworks  int
+	// WithStatus takes the TVStatus constants.
+	WithStatus IDFilter
+	// WithType takes the TVType constants.
+	WithType IDFilter
+
+	WithOriginCountry    string
+	WithOriginalLanguage string
+
+	WithoutCompanies []int
+	WithoutGenres    []int
+	WithoutKeywords  []int
+
+	// Watch provider filters require WatchRegion.
+	WatchRegion                string
+	WithWatchProviders         IDFilter
+	WithWatchMonetizationTypes []MonetizationType
+	WithoutWatchProviders      []int
+}
+
+// GetKey is empty since the options set many query params at once.
+func (o DiscoverMovieOptions) GetKey() string {
+	return ""
+}
+
+func (o DiscoverMovieOptions) Apply(v url.Values) {
+	setString(v, "sort_by", string(o.SortBy))
+	setInt(v, "page", o.Page)
+	setString(v, "language", o.Language)
+	setString(v, "region", o.Region)
+	setBool(v, "include_adult", o.IncludeAdult)
+	setBool(v, "include_video", o.IncludeVideo)
+
+	setString(v, "certification", o.Certification)
+	setString(v, "certification.gte", o.CertificationGte)
+	setString(v, "certification.lte", o.CertificationLte)
+	setString(v, "certification_country", o.CertificationCountry)
+
+	setInt(v, "year", o.Year)
+	setInt(v, "primary_release_year", o.PrimaryReleaseYear)
+	setDateRange(v, "primary_release_date", o.PrimaryReleaseDate)
+	setDateRange(v, "release_date", o.ReleaseDate)
+	setIDFilter(v, "with_release_type", o.WithReleaseType)
+
+	setRange(v, "vote_average", o.VoteAverage)
+	setRange(v, "vote_count", o.VoteCount)
+	setRange(v, "with_runtime", o.WithRuntime)
+
+	setIDFilter(v, "with_cast", o.WithCast)
+	setIDFilter(v, "with_crew", o.WithCrew)
+	setIDFilter(v, "with_people", o.WithPeople)
+	setIDFilter(v, "with_companies", o.WithCompanies)
+	setIDFilter(v, "with_genres", o.WithGenres)
+	setIDFilter(v, "with_keywords", o.WithKeywords)
+	setString(v, "with_origin_country", o.WithOriginCountry)
+	setString(v, "with_original_language", o.WithOriginalLanguage)
+	setInts(v, "without_companies", o.WithoutCompanies)
+	setInts(v, "without_genres", o.WithoutGenres)
+	setInts(v, "without_keywords", o.WithoutKeywords)
+
+	setString(v, "watch_region", o.WatchRegion)
+	setIDFilter(v, "with_watch_providers", o.WithWatchProviders)
+	setMonetizationTypes(v, o.WithWatchMonetizationTypes)
+	setInts(v, "without_watch_providers", o.WithoutWatchProviders)
+}
+
+func (o DiscoverMovieOptions) Validate() error {
+	if o.SortBy != "" && !containsSortBy(movieSortBys, o.SortBy) {
+		return fmt.Errorf("%w: %q cannot sort movies", ErrInvalidQueryParams, o.SortBy)
+	}
+	if (o.Certification != "" || o.CertificationGte != "" || o.CertificationLte != "") && o.CertificationCountry == "" {
+		return fmt.Errorf("%w: certification filters require a certification country", ErrInvalidQueryParams)
+	}
+	if err := validateIDRange("with_release_type", o.WithReleaseType.IDs, ReleaseTypePremiere, ReleaseTypeTV); err != nil {
+		return err
+	}
+	if err := validateDateRange("primary_release_date", o.PrimaryReleaseDate); err != nil {
+		return err
+	}
+	if err := validateDateRange("release_date", o.ReleaseDate); err != nil {
+		return err
+	}
+	if err := validateRanges(o.VoteAverage, o.VoteCount, o.WithRuntime); err != nil {
+		return err
+	}
+	return validateWatchProviders(o.WatchRegion, o.WithWatchProviders, o.WithWatchMonetizationTypes, o.WithoutWatchProviders)
+}
+
+// GetKey is empty since the options set many query params at once.
+func (o DiscoverTVOptions) GetKey() string {
+	return ""
+}
+
+func (o DiscoverTVOptions) Apply(v url.Values) {
+	setString(v, "sort_by", string(o.SortBy))
+	setInt(v, "page", o.Page)
+	setString(v, "language", o.Language)
+	setString(v, "timezone", o.Timezone)
+	setBool(v, "include_adult", o.IncludeAdult)
+	setBool(v, "include_null_first_air_dates", o.IncludeNullFirstAirDates)
+	setBool(v, "screened_theatrically", o.ScreenedTheatrically)
+
+	setInt(v, "first_air_date_year", o.FirstAirDateYear)
+	setDateRange(v, "first_air_date", o.FirstAirDate)
+	setDateRange(v, "air_date", o.AirDate)
+
+	setRange(v, "vote_average", o.VoteAverage)
+	setRange(v, "vote_count", o.VoteCount)
+	setRange(v, "with_runtime", o.WithRuntime)
+
+	setIDFilter(v, "with_companies", o.WithCompanies)
+	setIDFilter(v, "with_genres", o.WithGenres)
+	setIDFilter(v, "with_keywords", o.WithKeywords)
+	setInt(v, "with_networks", o.WithNetworks)
+	setString(v, "with_origin_country", o.WithOriginCountry)
+	setString(v, "with_original_language", o.WithOriginalLanguage)
+	setIDFilter(v, "with_status", o.WithStatus)
+	setIDFilter(v, "with_type", o.WithType)
+	setInts(v, "without_companies", o.WithoutCompanies)
+	setInts(v, "without_genres", o.WithoutGenres)
+	setInts(v, "without_keywords", o.WithoutKeywords)
+
+	setString(v, "watch_region", o.WatchRegion)
+	setIDFilter(v, "with_watch_providers", o.WithWatchProviders)
+	setMonetizationTypes(v, o.WithWatchMonetizationTypes)
+	setInts(v, "without_watch_providers", o.WithoutWatchProviders)
+}
+
+func (o DiscoverTVOptions) Validate() error {
+	if o.SortBy != "" && !containsSortBy(tvSortBys, o.SortBy) {
+		return fmt.Errorf("%w: %q cannot sort tv shows", ErrInvalidQueryParams, o.SortBy)
+	}
+	if err := validateIDRange("with_status", o.WithStatus.IDs, TVStatusReturningSeries, TVStatusPilot); err != nil {
+		return err
+	}
+	if err := validateIDRange("with_type", o.WithType.IDs, TVTypeDocumentary, TVTypeVideo); err != nil {
+		return err
+	}
+	if err := validateDateRange("first_air_date", o.FirstAirDate); err != nil {
+		return err
+	}
+	if err := validateDateRange("air_date", o.AirDate); err != nil {
+		return err
+	}
+	if err := validateRanges(o.VoteAverage, o.VoteCount, o.WithRuntime); err != nil {
+		return err
+	}
+	return validateWatchProviders(o.WatchRegion, o.WithWatchProviders, o.WithWatchMonetizationTypes, o.WithoutWatchProviders)
+}
+
+func validateIDRange(key string, ids []int, min, max int) error {
+	for _, id := range ids {
+		if id < min || id > max {
+			return fmt.Errorf("%w: %s must be between %d and %d, got %d", ErrInvalidQueryParams, key, min, max, id)
+		}
+	}
+	return nil
+}
+
+func validateDateRange(key string, r DateRange) error {
+	if !r.From.IsZero() && !r.To.IsZero() && r.From.After(r.To) {
+		return fmt.Errorf("%w: %s range starts after it ends", ErrInvalidQueryParams, key)
+	}
+	return nil
+}
+
+func validateRanges(voteAverage, voteCount, runtime Range) error {
+	ranges := []struct {
+		key   string
+		r     Range
+		limit float64
+	}{
+		{"vote_average", voteAverage, 10},
+		{"vote_count", voteCount, 0},
+		{"with_runtime", runtime, 0},
+	}
+	for _, rng := range ranges {
+		if rng.r.Min < 0 || rng.r.Max < 0 {
+			return fmt.Errorf("%w: %s cannot be negative", ErrInvalidQueryParams, rng.key)
+		}
+		if rng.r.Max != 0 && rng.r.Min > rng.r.Max {
+			return fmt.Errorf("%w: %s minimum is above its maximum", ErrInvalidQueryParams, rng.key)
+		}
+		if rng.limit != 0 && (rng.r.Min > rng.limit || rng.r.Max > rng.limit) {
+			return fmt.Errorf("%w: %s cannot be above %g", ErrInvalidQueryParams, rng.key, rng.limit)
+		}
+	}
+	return nil
+}
+
+func validateWatchProviders(region string, providers IDFilter, types []MonetizationType, without []int) error {
+	if region == "" && (len(providers.IDs) > 0 || len(types) > 0 || len(without) > 0) {
+		return fmt.Errorf("%w: watch provider filters require a watch region", ErrInvalidQueryParams)
+	}
+	for _, t := range types {
+		switch t {
+		case MonetizationFlatrate, MonetizationFree, MonetizationAds, MonetizationRent, MonetizationBuy:
+		default:
+			return fmt.Errorf("%w: unknown monetization type %q", ErrInvalidQueryParams, t)
+		}
+	}
+	return nil
+}
+
+func containsSortBy(sortBys []SortBy, sortBy SortBy) bool {
+	for _, s := range sortBys {
+		if s == sortBy {
+			return true
+		}
+	}
+	return false
+}
+
+func setString(v url.Values, key, value string) {
+	if value != "" {
+		v.Set(key, value)
+	}
+}
+
+func setInt(v url.Values, key string, value int) {
+	if value != 0 {
+		v.Set(key, strconv.Itoa(value))
+	}
+}
+
+func setBool(v url.Values, key string, value bool) {
+	if value {
+		v.Set(key, "true")
+	}
+}
+
+func setInts(v url.Values, key string, values []int) {
+	if len(values) > 0 {
+		v.Set(key, joinInts(values, ","))
+	}
+}
+
+func setIDFilter(v url.Values, key string, f IDFilter) {
+	if len(f.IDs) > 0 {
+		v.Set(key, f.String())
+	}
+}
+
+func setDateRange(v url.Values, key string, r DateRange) {
+	if !r.From.IsZero() {
+		v.Set(key+".gte", r.From.Format(time.DateOnly))
+	}
+	if !r.To.IsZero() {
+		v.Set(key+".lte", r.To.Format(time.DateOnly))
+	}
+}
+
+func setRange(v url.Values, key string, r Range) {
+	if r.Min != 0 {
+		v.Set(key+".gte", strconv.FormatFloat(r.Min, 'f', -1, 64))
+	}
+	if r.Max != 0 {
+		v.Set(key+".lte", strconv.FormatFloat(r.Max, 'f', -1, 64))
+	}
+}
+
+func setMonetizationTypes(v url.Values, types []MonetizationType) {
+	if len(types) == 0 {
+		return
+	}
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = string(t)
+	}
+	v.Set("with_watch_monetization_types", strings.Join(names, "|"))
+}
+
+func joinInts(values []int, sep string) string {
+	parts := make([]string, len(values))
+	for i, value := range values {
+		parts[i] = strconv.Itoa(value)
+	}
+	return strings.Join(parts, sep)
+}
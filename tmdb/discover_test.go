@@ -0,0 +1,125 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDiscoverClient(t *testing.T) {
+	t.Run("Encodes movie options", func(t *testing.T) {
+		var query url.Values
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			query = r.URL.Query()
+			_, _ = w.Write([]byte(`{"page":1,"total_pages":1,"total_results":1,"results":[{"id":18148,"title":"Tokyo Story"}]}`))
+		})
+		defer testServer.Close()
+
+		result, err := testClient.Discover.GetMovies(context.Background(), DiscoverMovieOptions{
+			SortBy:                     SortByVoteAverageDesc,
+			WithGenres:                 And(18, 10751),
+			WithKeywords:               Or(1, 2),
+			PrimaryReleaseDate:         DateRange{From: time.Date(1950, 1, 1, 0, 0, 0, 0, time.UTC), To: time.Date(1959, 12, 31, 0, 0, 0, 0, time.UTC)},
+			VoteAverage:                Range{Min: 7.5},
+			VoteCount:                  Range{Min: 100},
+			WithRuntime:                Range{Max: 150},
+			CertificationCountry:       "US",
+			CertificationLte:           "PG-13",
+			WatchRegion:                "US",
+			WithWatchProviders:         Or(8, 9),
+			WithWatchMonetizationTypes: []MonetizationType{MonetizationFlatrate, MonetizationFree},
+			WithoutGenres:              []int{27, 53},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if result.Results[0].ID != 18148 {
+			t.Errorf("expected movie ID 18148, got %d", result.Results[0].ID)
+		}
+
+		expected := map[string]string{
+			"sort_by":                       "vote_average.desc",
+			"with_genres":                   "18,10751",
+			"with_keywords":                 "1|2",
+			"primary_release_date.gte":      "1950-01-01",
+			"primary_release_date.lte":      "1959-12-31",
+			"vote_average.gte":              "7.5",
+			"vote_count.gte":                "100",
+			"with_runtime.lte":              "150",
+			"certification_country":         "US",
+			"certification.lte":             "PG-13",
+			"watch_region":                  "US",
+			"with_watch_providers":          "8|9",
+			"with_watch_monetization_types": "flatrate|free",
+			"without_genres":                "27,53",
+		}
+		for key, value := range expected {
+			if query.Get(key) != value {
+				t.Errorf("expected %s=%s, got %q", key, value, query.Get(key))
+			}
+		}
+
+		if len(query) != len(expected) {
+			t.Errorf("expected %d query params, got %v", len(expected), query)
+		}
+	})
+
+	t.Run("Encodes tv options", func(t *testing.T) {
+		var query url.Values
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			query = r.URL.Query()
+			_, _ = w.Write([]byte(`{"page":1,"total_pages":1,"total_results":0,"results":[]}`))
+		})
+		defer testServer.Close()
+
+		_, err := testClient.Discover.GetTVShows(context.Background(), DiscoverTVOptions{
+			SortBy:     SortByFirstAirDateDesc,
+			WithStatus: Or(TVStatusInProduction, TVStatusEnded),
+			WithType:   And(TVTypeScripted),
+		}, SingleQueryParam{"language", "en-US"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if query.Get("with_status") != "2|3" || query.Get("with_type") != "4" || query.Get("language") != "en-US" {
+			t.Errorf("unexpected query %v", query)
+		}
+	})
+
+	t.Run("Rejects invalid options", func(t *testing.T) {
+		var calls int32
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+		})
+		defer testServer.Close()
+
+		movieOptions := []DiscoverMovieOptions{
+			{SortBy: SortByFirstAirDateAsc},
+			{Certification: "R"},
+			{WithWatchProviders: Or(8)},
+			{WatchRegion: "US", WithWatchMonetizationTypes: []MonetizationType{"stream"}},
+			{VoteAverage: Range{Min: 8, Max: 6}},
+			{VoteAverage: Range{Max: 11}},
+			{ReleaseDate: DateRange{From: time.Now(), To: time.Now().AddDate(-1, 0, 0)}},
+			{WithReleaseType: Or(ReleaseTypeTheatrical, 7)},
+		}
+		for _, options := range movieOptions {
+			if _, err := testClient.Discover.GetMovies(context.Background(), options); !errors.Is(err, ErrInvalidQueryParams) {
+				t.Errorf("expected ErrInvalidQueryParams for %+v, got %v", options, err)
+			}
+		}
+
+		if _, err := testClient.Discover.GetTVShows(context.Background(), DiscoverTVOptions{WithStatus: And(6)}); !errors.Is(err, ErrInvalidQueryParams) {
+			t.Errorf("expected ErrInvalidQueryParams, got %v", err)
+		}
+
+		if calls != 0 {
+			t.Errorf("expected no request to be sent, got %d", calls)
+		}
+	})
+}
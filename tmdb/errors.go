@@ -3,23 +3,71 @@ package tmdb
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"time"
 )
 
 var (
 	ErrBearerTokenMissing = errors.New("bearer token missing")
 	ErrApiKeyMissing      = errors.New("api key missing")
+	ErrClientRateLimited  = errors.New("client rate limit exceeded")
+	ErrSessionMissing     = errors.New("session missing")
 )
 
+// Sentinel errors matched by *TmdbError through errors.Is, based on the HTTP status of the response.
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrServerError  = errors.New("server error")
+)
+
+// TmdbError is returned for every non 2xx response from the TMDb API.
+//
+// Use errors.Is with ErrNotFound, ErrUnauthorized, ErrRateLimited or ErrServerError to
+// check the kind of failure and errors.As to get to the details, e.g. RetryAfter.
 type TmdbError struct {
 	StatusMessage string `json:"status_message"`
 	StatusCode    int    `json:"status_code"`
 	Success       bool   `json:"success"`
+
+	// HTTPStatus is the status code of the HTTP response.
+	HTTPStatus int `json:"-"`
+	// Method is the HTTP method of the failed request.
+	Method string `json:"-"`
+	// Path is the request path with guest session IDs redacted. It never contains the query string,
+	// so no credentials leak through it.
+	Path string `json:"-"`
+	// RawBody is the unparsed response body, e.g. an HTML error page from a CDN.
+	RawBody []byte `json:"-"`
+	// RetryAfter is the delay requested by the Retry-After header, zero when absent.
+	RetryAfter time.Duration `json:"-"`
 }
 
 func (e *TmdbError) Error() string {
-	return fmt.Sprintf("Code: %d, Status Message: %s", e.StatusCode, e.StatusMessage)
+	if e.HTTPStatus == 0 {
+		return fmt.Sprintf("Code: %d, Status Message: %s", e.StatusCode, e.StatusMessage)
+	}
+	return fmt.Sprintf("%s %s: HTTP %d, Code: %d, Status Message: %s", e.Method, e.Path, e.HTTPStatus, e.StatusCode, e.StatusMessage)
+}
+
+// Is reports whether the error matches one of the sentinel errors of this package.
+func (e *TmdbError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.HTTPStatus == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.HTTPStatus == http.StatusUnauthorized
+	case ErrRateLimited:
+		return e.HTTPStatus == http.StatusTooManyRequests
+	case ErrServerError:
+		return e.HTTPStatus >= http.StatusInternalServerError
+	}
+	return false
 }
 
 var (
 	ErrInvalidQueryParams = errors.New("invalid query params")
+	ErrUnknownImageSize   = errors.New("unknown image size")
+	ErrInvalidRating      = errors.New("invalid rating")
 )
@@ -0,0 +1,181 @@
+package tmdb
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// ExportKind is one of the daily ID export files TMDb publishes.
+type ExportKind string
+
+const (
+	ExportMovies              ExportKind = "movie_ids"
+	ExportTvSeries            ExportKind = "tv_series_ids"
+	ExportPeople              ExportKind = "person_ids"
+	ExportCollections         ExportKind = "collection_ids"
+	ExportKeywords            ExportKind = "keyword_ids"
+	ExportTvNetworks          ExportKind = "tv_network_ids"
+	ExportProductionCompanies ExportKind = "production_company_ids"
+)
+
+const exportBaseURL = "https://files.tmdb.org/p/exports/"
+
+// ExportURL returns the URL of the export file of kind published on day. Files are published
+// around 8 AM UTC and kept for three months.
+func ExportURL(kind ExportKind, day time.Time) string {
+	return fmt.Sprintf("%s%s_%s.json.gz", exportBaseURL, kind, day.UTC().Format("01_02_2006"))
+}
+
+// ExportRecord is a line of an export file. Which fields are set depends on the kind of the file,
+// e.g. OriginalTitle and Video only for movies.
+type ExportRecord struct {
+	ID            int32   `json:"id"`
+	Adult         bool    `json:"adult"`
+	Name          string  `json:"name"`
+	OriginalName  string  `json:"original_name"`
+	OriginalTitle string  `json:"original_title"`
+	Popularity    float64 `json:"popularity"`
+	Video         bool    `json:"video"`
+}
+
+// ExportFilter skips records of an export file. The zero value yields every record.
+type ExportFilter struct {
+	// MinPopularity skips records less popular than this. Only the movie, tv series and people
+	// files carry a popularity, records of the other kinds are never skipped by it.
+	MinPopularity float64
+	// SkipAdult skips records flagged as adult.
+	SkipAdult bool
+}
+
+func (f ExportFilter) match(record *ExportRecord, hasPopularity bool) bool {
+	if f.SkipAdult && record.Adult {
+		return false
+	}
+	return !hasPopularity || record.Popularity >= f.MinPopularity
+}
+
+// exportLine tells records without a popularity apart from those with a popularity of 0.
+type exportLine struct {
+	ExportRecord
+	Popularity *float64 `json:"popularity"`
+}
+
+// ExportReader streams the records of an export file, one line at a time:
+//
+//	reader, err := tmdb.OpenExportFile("movie_ids_05_15_2024.json.gz", tmdb.ExportFilter{MinPopularity: 1})
+//	if err != nil {
+//		// handle error here
+//	}
+//	defer reader.Close()
+//	for reader.Next() {
+//		record := reader.Record()
+//	}
+//	if err := reader.Err(); err != nil {
+//		// handle error here
+//	}
+type ExportReader struct {
+	scanner *bufio.Scanner
+	closers []io.Closer
+	filter  ExportFilter
+	line    int
+	record  ExportRecord
+	err     error
+}
+
+// NewExportReader returns a reader over the export file in r, which may be gzipped or not.
+func NewExportReader(r io.Reader, filter ExportFilter) (*ExportReader, error) {
+	buffered := bufio.NewReader(r)
+	reader := &ExportReader{filter: filter}
+
+	// gzip streams start with the magic bytes 0x1f 0x8b, JSON lines never do
+	var source io.Reader = buffered
+	if magic, err := buffered.Peek(2); err == nil && bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return nil, err
+		}
+		reader.closers = append(reader.closers, gz)
+		source = gz
+	}
+
+	reader.scanner = bufio.NewScanner(source)
+	reader.scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	return reader, nil
+}
+
+// OpenExportFile returns a reader over the export file at path. Close it once done.
+func OpenExportFile(path string, filter ExportFilter) (*ExportReader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := NewExportReader(f, filter)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	reader.closers = append(reader.closers, f)
+	return reader, nil
+}
+
+// Next advances to the next record passing the filter. It returns false at the end of the file
+// or on an error; check Err to tell them apart.
+func (r *ExportReader) Next() bool {
+	if r.err != nil {
+		return false
+	}
+
+	for r.scanner.Scan() {
+		r.line++
+		line := bytes.TrimSpace(r.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var decoded exportLine
+		if err := json.Unmarshal(line, &decoded); err != nil {
+			r.err = fmt.Errorf("export line %d: %w", r.line, err)
+			return false
+		}
+		record := decoded.ExportRecord
+		if decoded.Popularity != nil {
+			record.Popularity = *decoded.Popularity
+		}
+		if r.filter.match(&record, decoded.Popularity != nil) {
+			r.record = record
+			return true
+		}
+	}
+
+	r.err = r.scanner.Err()
+	return false
+}
+
+// Record returns the current record, valid after Next returned true.
+func (r *ExportReader) Record() ExportRecord {
+	return r.record
+}
+
+// Err returns the error that stopped the reader, if any.
+func (r *ExportReader) Err() error {
+	return r.err
+}
+
+// Close closes the file opened by OpenExportFile. Readers from NewExportReader leave r open.
+func (r *ExportReader) Close() error {
+	var firstErr error
+	for _, closer := range r.closers {
+		if err := closer.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	r.closers = nil
+	return firstErr
+}
@@ -0,0 +1,107 @@
+package tmdb
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const movieExport = `{"adult":false,"id":3924,"original_title":"Blondie","popularity":2.338,"video":false}
+{"adult":false,"id":18148,"original_title":"東京物語","popularity":17.021,"video":false}
+{"adult":true,"id":2,"original_title":"Adult","popularity":30.1,"video":false}
+
+{"adult":false,"id":5,"original_title":"Four Rooms","popularity":0.5,"video":true}
+`
+
+func readExportIDs(t *testing.T, reader *ExportReader) []int32 {
+	t.Helper()
+	var ids []int32
+	for reader.Next() {
+		ids = append(ids, reader.Record().ID)
+	}
+	if err := reader.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return ids
+}
+
+func TestExportReader(t *testing.T) {
+	t.Run("Reads plain and gzipped files", func(t *testing.T) {
+		reader, err := NewExportReader(strings.NewReader(movieExport), ExportFilter{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ids := readExportIDs(t, reader); len(ids) != 4 {
+			t.Errorf("expected 4 records, got %v", ids)
+		}
+
+		var gzipped bytes.Buffer
+		gz := gzip.NewWriter(&gzipped)
+		_, _ = gz.Write([]byte(movieExport))
+		_ = gz.Close()
+
+		path := filepath.Join(t.TempDir(), "movie_ids_05_15_2024.json.gz")
+		if err := os.WriteFile(path, gzipped.Bytes(), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		fileReader, err := OpenExportFile(path, ExportFilter{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer fileReader.Close()
+
+		if !fileReader.Next() {
+			t.Fatalf("expected a record, got %v", fileReader.Err())
+		}
+		if record := fileReader.Record(); record.ID != 3924 || record.OriginalTitle != "Blondie" || record.Popularity != 2.338 {
+			t.Errorf("expected Blondie, got %+v", record)
+		}
+	})
+
+	t.Run("Filters by popularity and adult", func(t *testing.T) {
+		reader, err := NewExportReader(strings.NewReader(movieExport), ExportFilter{MinPopularity: 1, SkipAdult: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ids := readExportIDs(t, reader); len(ids) != 2 || ids[0] != 3924 || ids[1] != 18148 {
+			t.Errorf("expected records 3924 and 18148, got %v", ids)
+		}
+	})
+
+	t.Run("Popularity filter skips no records of kinds without popularity", func(t *testing.T) {
+		keywords := `{"id":818,"name":"based on novel or book"}
+{"id":9715,"name":"superhero"}
+`
+		reader, err := NewExportReader(strings.NewReader(keywords), ExportFilter{MinPopularity: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ids := readExportIDs(t, reader); len(ids) != 2 || ids[0] != 818 || ids[1] != 9715 {
+			t.Errorf("expected keywords 818 and 9715, got %v", ids)
+		}
+	})
+
+	t.Run("Reports malformed lines", func(t *testing.T) {
+		reader, err := NewExportReader(strings.NewReader(movieExport+"{\"id\":\"x\"}\n"), ExportFilter{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for reader.Next() {
+		}
+		if err := reader.Err(); err == nil || !strings.Contains(err.Error(), "line 6") {
+			t.Errorf("expected an error on line 6, got %v", err)
+		}
+	})
+
+	t.Run("Export URL", func(t *testing.T) {
+		url := ExportURL(ExportTvSeries, time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC))
+		if url != "https://files.tmdb.org/p/exports/tv_series_ids_05_15_2024.json.gz" {
+			t.Errorf("unexpected url %s", url)
+		}
+	})
+}
@@ -3,45 +3,109 @@ package tmdb
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
 )
 
 type FindService interface {
-	FindByID(ctx context.Context, externalId string, externalSource queryParam, queryParams ...queryParam) (*FindResponse, error)
+	FindByID(ctx context.Context, externalId string, externalSource ExternalSource, queryParams ...QueryParam) (*FindResponse, error)
 }
 
 type FindClient struct {
 	baseClient *Client
 }
 
+// ExternalSource is the site an external ID passed to FindByID comes from.
+type ExternalSource string
+
+const (
+	ExternalSourceImdb      ExternalSource = "imdb_id"
+	ExternalSourceFacebook  ExternalSource = "facebook_id"
+	ExternalSourceInstagram ExternalSource = "instagram_id"
+	ExternalSourceTiktok    ExternalSource = "tiktok_id"
+	ExternalSourceTvdb      ExternalSource = "tvdb_id"
+	ExternalSourceTwitter   ExternalSource = "twitter_id"
+	ExternalSourceWikidata  ExternalSource = "wikidata_id"
+	ExternalSourceYoutube   ExternalSource = "youtube_id"
+)
+
+var externalSources = []ExternalSource{
+	ExternalSourceImdb,
+	ExternalSourceFacebook,
+	ExternalSourceInstagram,
+	ExternalSourceTiktok,
+	ExternalSourceTvdb,
+	ExternalSourceTwitter,
+	ExternalSourceWikidata,
+	ExternalSourceYoutube,
+}
+
+func (s ExternalSource) GetKey() string {
+	return "external_source"
+}
+
+func (s ExternalSource) Apply(v url.Values) {
+	v.Set(s.GetKey(), string(s))
+}
+
+func (s ExternalSource) Validate() error {
+	for _, source := range externalSources {
+		if s == source {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: unknown external source %q", ErrInvalidQueryParams, string(s))
+}
+
+type FindTvEpisodeResult struct {
+	AirDate        string  `json:"air_date"`
+	EpisodeNumber  int32   `json:"episode_number"`
+	EpisodeType    string  `json:"episode_type"`
+	ID             int32   `json:"id"`
+	MediaType      string  `json:"media_type"`
+	Name           string  `json:"name"`
+	Overview       string  `json:"overview"`
+	ProductionCode string  `json:"production_code"`
+	Runtime        int32   `json:"runtime"`
+	SeasonNumber   int32   `json:"season_number"`
+	ShowID         int32   `json:"show_id"`
+	StillPath      string  `json:"still_path"`
+	VoteAverage    float64 `json:"vote_average"`
+	VoteCount      int32   `json:"vote_count"`
+}
+
+type FindTvSeasonResult struct {
+	AirDate      string  `json:"air_date"`
+	EpisodeCount int32   `json:"episode_count"`
+	ID           int32   `json:"id"`
+	MediaType    string  `json:"media_type"`
+	Name         string  `json:"name"`
+	Overview     string  `json:"overview"`
+	PosterPath   string  `json:"poster_path"`
+	SeasonNumber int32   `json:"season_number"`
+	ShowID       int32   `json:"show_id"`
+	VoteAverage  float64 `json:"vote_average"`
+}
+
 type FindResponse struct {
-	MovieResults []struct {
-		Adult            bool    `json:"adult"`
-		BackdropPath     string  `json:"backdrop_path"`
-		GenreIds         []int32 `json:"genre_ids"`
-		ID               int32   `json:"id"`
-		MediaType        string  `json:"media_type"`
-		OriginalLanguage string  `json:"original_language"`
-		OriginalTitle    string  `json:"original_title"`
-		Overview         string  `json:"overview"`
-		Popularity       float64 `json:"popularity"`
-		PosterPath       string  `json:"poster_path"`
-		ReleaseDate      string  `json:"release_date"`
-		Title            string  `json:"title"`
-		Video            bool    `json:"video"`
-		VoteAverage      float64 `json:"vote_average"`
-		VoteCount        int32   `json:"vote_count"`
-	} `json:"movie_results"`
-
-	// TODO: figure out what these are
-	PersonResults    []interface{} `json:"person_results"`
-	TvResults        []interface{} `json:"tv_results"`
-	TvEpisodeResults []interface{} `json:"tv_episode_results"`
-	TvSeasonResults  []interface{} `json:"tv_season_results"`
-}
-
-func (fc *FindClient) FindByID(ctx context.Context, externalId string, externalSource queryParam, queryParams ...queryParam) (*FindResponse, error) {
-	resp, err := fc.baseClient.request(ctx, http.MethodGet, "/find/"+externalId, append(queryParams, externalSource)...)
+	MovieResults     []MovieResult         `json:"movie_results"`
+	PersonResults    []PersonResult        `json:"person_results"`
+	TvResults        []TvResult            `json:"tv_results"`
+	TvEpisodeResults []FindTvEpisodeResult `json:"tv_episode_results"`
+	TvSeasonResults  []FindTvSeasonResult  `json:"tv_season_results"`
+}
+
+// FindByID looks up movies, people, tv series, seasons and episodes by an ID of another site,
+// for example an IMDb ID with ExternalSourceImdb.
+func (fc *FindClient) FindByID(ctx context.Context, externalId string, externalSource ExternalSource, queryParams ...QueryParam) (*FindResponse, error) {
+	if externalId == "" {
+		return nil, fmt.Errorf("%w: external id is empty", ErrInvalidQueryParams)
+	}
+
+	// copied so the caller's slice is never written to
+	params := append(append([]QueryParam(nil), queryParams...), externalSource)
+	resp, err := fc.baseClient.request(ctx, http.MethodGet, "/find/"+url.PathEscape(externalId), params...)
 	if err != nil {
 		return nil, err
 	}
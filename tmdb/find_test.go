@@ -2,6 +2,7 @@ package tmdb
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"testing"
 )
@@ -16,7 +17,7 @@ func TestFindClient(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		result, err := testClient.Find.FindByID(context.Background(), "tt0076759", SingleQueryParam{"external_source", "imdb_id"})
+		result, err := testClient.Find.FindByID(context.Background(), "tt0076759", ExternalSourceImdb)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -33,4 +34,64 @@ func TestFindClient(t *testing.T) {
 			t.Errorf("expected movie title Tokyo Story, got %s", result.MovieResults[0].Title)
 		}
 	})
+
+	t.Run("Find episode by tvdb id", func(t *testing.T) {
+		testClient, testServer, err := newTestClientAndServerWithFile(http.StatusOK, "testdata/get_find_by_tvdb_id.json")
+		defer testServer.Close()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		result, err := testClient.Find.FindByID(context.Background(), "349232", ExternalSourceTvdb)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(result.TvEpisodeResults) != 1 {
+			t.Fatalf("expected 1 episode result, got %d", len(result.TvEpisodeResults))
+		}
+
+		episode := result.TvEpisodeResults[0]
+		if episode.ShowID != 1396 || episode.SeasonNumber != 1 || episode.EpisodeNumber != 1 {
+			t.Errorf("expected episode 1x1 of show 1396, got %dx%d of show %d", episode.SeasonNumber, episode.EpisodeNumber, episode.ShowID)
+		}
+	})
+
+	t.Run("Rejects unknown external sources", func(t *testing.T) {
+		testClient, testServer, err := newTestClientAndServerWithFile(http.StatusOK, "testdata/get_find_by_id.json")
+		defer testServer.Close()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := testClient.Find.FindByID(context.Background(), "tt0076759", ExternalSource("imdb")); !errors.Is(err, ErrInvalidQueryParams) {
+			t.Errorf("expected ErrInvalidQueryParams, got %v", err)
+		}
+
+		if _, err := testClient.Find.FindByID(context.Background(), "", ExternalSourceImdb); !errors.Is(err, ErrInvalidQueryParams) {
+			t.Errorf("expected ErrInvalidQueryParams, got %v", err)
+		}
+	})
+
+	t.Run("Leaves the caller's params untouched", func(t *testing.T) {
+		testClient, testServer, err := newTestClientAndServerWithFile(http.StatusOK, "testdata/get_find_by_id.json")
+		defer testServer.Close()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		params := make([]QueryParam, 2, 4)
+		params[0] = SingleQueryParam{Key: "language", Value: "en-US"}
+		params[1] = SingleQueryParam{Key: "language", Value: "de-DE"}
+		if _, err := testClient.Find.FindByID(context.Background(), "tt0076759", ExternalSourceImdb, params[:1]...); err != nil {
+			t.Fatal(err)
+		}
+
+		if params[1] != (SingleQueryParam{Key: "language", Value: "de-DE"}) {
+			t.Errorf("expected the caller's params to be kept, got %v", params[1])
+		}
+	})
 }
@@ -0,0 +1,154 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+// flightGroup coalesces identical in-flight GET requests so only one HTTP call goes out.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+type flightCall struct {
+	done    chan struct{}
+	entry   *CacheEntry
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+func newFlightGroup() *flightGroup {
+	return &flightGroup{calls: make(map[string]*flightCall)}
+}
+
+// do runs fn once for all concurrent callers with the same key and hands every caller
+// its own response built from the shared result.
+//
+// fn runs detached from the cancellation of any single caller: a caller whose context is
+// done returns right away while the others keep waiting. The call is cancelled once no caller
+// is left. fn keeps the deadline of the caller that started it; callers with a later deadline
+// start a new call when that deadline cuts the shared one short.
+func (g *flightGroup) do(ctx context.Context, key string, fn func(ctx context.Context) (*CacheEntry, error)) (*http.Response, error) {
+	for {
+		call, started := g.join(ctx, key, fn)
+
+		select {
+		case <-call.done:
+			if call.err != nil {
+				// the deadline of whoever started the call was shorter than ours
+				if !started && ctx.Err() == nil && errors.Is(call.err, context.DeadlineExceeded) {
+					continue
+				}
+				return nil, call.err
+			}
+			return call.entry.response(), nil
+		case <-ctx.Done():
+			g.leave(key, call)
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// join adds the caller to the in-flight call for key, starting one if there is none.
+// started reports whether the caller started the call.
+func (g *flightGroup) join(ctx context.Context, key string, fn func(ctx context.Context) (*CacheEntry, error)) (call *flightCall, started bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if call, ok := g.calls[key]; ok {
+		call.waiters++
+		return call, false
+	}
+
+	flightCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	if deadline, ok := ctx.Deadline(); ok {
+		var cancelDeadline context.CancelFunc
+		flightCtx, cancelDeadline = context.WithDeadline(flightCtx, deadline)
+		cancelParent := cancel
+		cancel = func() {
+			cancelDeadline()
+			cancelParent()
+		}
+	}
+
+	call = &flightCall{done: make(chan struct{}), waiters: 1, cancel: cancel}
+	g.calls[key] = call
+
+	go func() {
+		call.entry, call.err = fn(flightCtx)
+
+		g.mu.Lock()
+		if g.calls[key] == call {
+			delete(g.calls, key)
+		}
+		g.mu.Unlock()
+
+		cancel()
+		close(call.done)
+	}()
+
+	return call, true
+}
+
+// leave drops a caller from the call and cancels it when nobody is waiting anymore.
+func (g *flightGroup) leave(key string, call *flightCall) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	call.waiters--
+	if call.waiters > 0 {
+		return
+	}
+
+	if g.calls[key] == call {
+		delete(g.calls, key)
+	}
+	call.cancel()
+}
+
+// bufferResponse reads the whole response so it can be handed to several callers.
+func bufferResponse(resp *http.Response) (*CacheEntry, error) {
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &CacheEntry{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       body,
+		StoredAt:   time.Now(),
+	}, nil
+}
+
+// coalescable reports whether concurrent GETs to endpoint may share one response. GETs that
+// create state, such as a new guest session, request token or clearing a list, must reach TMDb
+// once per caller.
+func coalescable(endpoint string) bool {
+	if endpoint == "/authentication" || strings.HasPrefix(endpoint, "/authentication/") {
+		return false
+	}
+	clearsList, _ := path.Match("/4/list/*/clear", endpoint)
+	return !clearsList
+}
+
+// WithRequestCoalescing turns the coalescing of identical concurrent GET requests on or off.
+// It is on by default.
+func WithRequestCoalescing(enabled bool) ClientOption {
+	return func(c *Client) {
+		if enabled {
+			c.flights = newFlightGroup()
+		} else {
+			c.flights = nil
+		}
+	}
+}
@@ -7,8 +7,8 @@ import (
 )
 
 type GenresService interface {
-	GetMovieGenres(ctx context.Context, queryParams ...queryParam) (*GenreList, error)
-	GetTVGenres(ctx context.Context, queryParams ...queryParam) (*GenreList, error)
+	GetMovieGenres(ctx context.Context, queryParams ...QueryParam) (*GenreList, error)
+	GetTVGenres(ctx context.Context, queryParams ...QueryParam) (*GenreList, error)
 }
 
 type GenreClient struct {
@@ -22,7 +22,7 @@ type GenreList struct {
 	} `json:"genres"`
 }
 
-func (gc *GenreClient) GetMovieGenres(ctx context.Context, queryParams ...queryParam) (*GenreList, error) {
+func (gc *GenreClient) GetMovieGenres(ctx context.Context, queryParams ...QueryParam) (*GenreList, error) {
 	resp, err := gc.baseClient.request(ctx, http.MethodGet, "/genre/movie/list", queryParams...)
 	if err != nil {
 		return nil, err
@@ -36,7 +36,7 @@ func (gc *GenreClient) GetMovieGenres(ctx context.Context, queryParams ...queryP
 	return &result, nil
 }
 
-func (gc *GenreClient) GetTVGenres(ctx context.Context, queryParams ...queryParam) (*GenreList, error) {
+func (gc *GenreClient) GetTVGenres(ctx context.Context, queryParams ...QueryParam) (*GenreList, error) {
 	resp, err := gc.baseClient.request(ctx, http.MethodGet, "/genre/tv/list", queryParams...)
 	if err != nil {
 		return nil, err
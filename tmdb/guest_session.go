@@ -0,0 +1,97 @@
+package tmdb
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// guestSessionExpiryLayout is the format of expires_at in guest session and request token responses.
+const guestSessionExpiryLayout = "2006-01-02 15:04:05 MST"
+
+// guestSessionMargin renews guest sessions a little before they expire, so requests in flight don't fail.
+const guestSessionMargin = time.Minute
+
+// Expiry parses ExpiresAt, e.g. "2024-05-15 09:00:00 UTC".
+func (r *GuestSessionResponse) Expiry() (time.Time, error) {
+	return time.Parse(guestSessionExpiryLayout, r.ExpiresAt)
+}
+
+// WithGuestSession returns a client sending guestSessionID, e.g. from
+// Authentication.CreateGuestSession, to every endpoint accepting it, such as the rating endpoints.
+func (c *Client) WithGuestSession(guestSessionID string) *Client {
+	return c.derive(func(c *Client) {
+		c.setDefaultParam("guest_session_id", guestSessionID)
+	})
+}
+
+// GuestSession hands out a guest session, creating a new one on first use and whenever the
+// current one expired. Guest sessions expire after 60 minutes without use, and a new session
+// does not see the ratings of the previous one. GuestSession is safe for concurrent use:
+//
+//	guest := tmdb.NewGuestSession(client)
+//	guestClient, err := guest.Client(ctx)
+//	if err != nil {
+//		// handle error here
+//	}
+//	_, err = guestClient.Movies.AddRating(ctx, 18148, 9)
+type GuestSession struct {
+	client    *Client
+	now       func() time.Time
+	mu        sync.Mutex
+	id        string
+	expiresAt time.Time
+	derived   *Client
+}
+
+func NewGuestSession(client *Client) *GuestSession {
+	return &GuestSession{client: client, now: time.Now}
+}
+
+// ID returns the ID of the current guest session, creating a new session when needed.
+func (g *GuestSession) ID(ctx context.Context) (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if err := g.renew(ctx); err != nil {
+		return "", err
+	}
+	return g.id, nil
+}
+
+// Client returns a client acting as the current guest, see Client.WithGuestSession.
+func (g *GuestSession) Client(ctx context.Context) (*Client, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if err := g.renew(ctx); err != nil {
+		return nil, err
+	}
+	return g.derived, nil
+}
+
+// ExpiresAt returns when the current guest session expires, zero before the first one was created.
+func (g *GuestSession) ExpiresAt() time.Time {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.expiresAt
+}
+
+func (g *GuestSession) renew(ctx context.Context) error {
+	if g.id != "" && g.now().Add(guestSessionMargin).Before(g.expiresAt) {
+		return nil
+	}
+
+	session, err := g.client.Authentication.CreateGuestSession(ctx)
+	if err != nil {
+		return err
+	}
+	expiresAt, err := session.Expiry()
+	if err != nil {
+		return err
+	}
+
+	g.id, g.expiresAt = session.GuestID, expiresAt
+	g.derived = g.client.WithGuestSession(session.GuestID)
+	return nil
+}
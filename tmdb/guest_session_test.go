@@ -0,0 +1,80 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestGuestSession(t *testing.T) {
+	var sessions int
+	var requests []recordedWrite
+	testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, recordedWrite{r.Method, r.URL.Path, r.URL.RawQuery, string(body)})
+		switch r.URL.Path {
+		case "/3/authentication/guest_session/new":
+			sessions++
+			_, _ = fmt.Fprintf(w, `{"success":true,"guest_session_id":"1ce82ec1223641636ad4a60b07de3581%d","expires_at":"2024-05-15 09:00:00 UTC"}`, sessions)
+		case "/3/guest_session/1ce82ec1223641636ad4a60b07de35811/rated/movies":
+			_, _ = w.Write([]byte(`{"page":1,"total_pages":1,"total_results":1,"results":[{"id":18148,"title":"Tokyo Story","rating":9}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"success":true,"status_code":1,"status_message":"Success."}`))
+		}
+	})
+	defer testServer.Close()
+
+	now := time.Date(2024, 5, 14, 9, 0, 0, 0, time.UTC)
+	guest := NewGuestSession(testClient)
+	guest.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	t.Run("Rates as the guest", func(t *testing.T) {
+		guestClient, err := guest.Client(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := guestClient.Movies.AddRating(ctx, 18148, 9); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := guestClient.TvEpisodes.AddRating(ctx, 1396, 1, 1, 7.5); err != nil {
+			t.Fatal(err)
+		}
+
+		id, err := guest.ID(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rated, err := testClient.GuestSessions.GetRatedMovies(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rated.Results) != 1 || rated.Results[0].ID != 18148 {
+			t.Errorf("expected Tokyo Story to be rated, got %+v", rated.Results)
+		}
+
+		if sessions != 1 {
+			t.Errorf("expected 1 guest session, got %d", sessions)
+		}
+		if query := requests[1].query; query != "guest_session_id=1ce82ec1223641636ad4a60b07de35811" {
+			t.Errorf("expected the rating to be sent as the guest, got %s", query)
+		}
+		if expiresAt := guest.ExpiresAt(); !expiresAt.Equal(time.Date(2024, 5, 15, 9, 0, 0, 0, time.UTC)) {
+			t.Errorf("unexpected expiry %s", expiresAt)
+		}
+	})
+
+	t.Run("Recreates expired sessions", func(t *testing.T) {
+		now = now.Add(24 * time.Hour)
+		id, err := guest.ID(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id != "1ce82ec1223641636ad4a60b07de35812" || sessions != 2 {
+			t.Errorf("expected a new guest session, got %s after %d sessions", id, sessions)
+		}
+	})
+}
@@ -8,84 +8,114 @@ import (
 )
 
 type GuestSessionsService interface {
-	GetRatedMovies(ctx context.Context, guestSessionId int32, queryParams ...queryParam) (*RatedMoviesResponse, error)
-	GetRatedTVShows(ctx context.Context, guestSessionId int32, queryParams ...queryParam) (*RatedTvShowsResponse, error)
-	GetRatedTVEpisodes(ctx context.Context, guestSessionId int32, queryParams ...queryParam) (*RatedTvShowEpisodesResponse, error)
+	GetRatedMovies(ctx context.Context, guestSessionId string, queryParams ...QueryParam) (*RatedMoviesResponse, error)
+	GetRatedTVShows(ctx context.Context, guestSessionId string, queryParams ...QueryParam) (*RatedTvShowsResponse, error)
+	GetRatedTVEpisodes(ctx context.Context, guestSessionId string, queryParams ...QueryParam) (*RatedTvShowEpisodesResponse, error)
 }
 
 type GuestSessionsClient struct {
 	baseClient *Client
 }
 
+type GuestRatedMovie struct {
+	Adult            bool    `json:"adult"`
+	BackdropPath     string  `json:"backdrop_path"`
+	GenreIds         []int32 `json:"genre_ids"`
+	ID               int32   `json:"id"`
+	OriginalLanguage string  `json:"original_language"`
+	OriginalTitle    string  `json:"original_title"`
+	Overview         string  `json:"overview"`
+	Popularity       float64 `json:"popularity"`
+	PosterPath       string  `json:"poster_path"`
+	ReleaseDate      string  `json:"release_date"`
+	Title            string  `json:"title"`
+	Video            bool    `json:"video"`
+	VoteAverage      float64 `json:"vote_average"`
+	VoteCount        int32   `json:"vote_count"`
+	Rating           float64 `json:"rating"`
+}
+
 type RatedMoviesResponse struct {
-	Page    int32 `json:"page"`
-	Results []struct {
-		Adult            bool    `json:"adult"`
-		BackdropPath     string  `json:"backdrop_path"`
-		GenreIds         []int32 `json:"genre_ids"`
-		ID               int32   `json:"id"`
-		OriginalCountry  string  `json:"original_country"`
-		OriginalLanguage string  `json:"original_language"`
-		OriginalName     string  `json:"original_name"`
-		Overview         string  `json:"overview"`
-		Popularity       float64 `json:"popularity"`
-		PosterPath       string  `json:"poster_path"`
-		FirstAirDate     string  `json:"first_air_date"`
-		Name             string  `json:"name"`
-		VoteAverage      float64 `json:"vote_average"`
-		VoteCount        int32   `json:"vote_count"`
-		Rating           float64 `json:"rating"`
-	} `json:"results"`
-	TotalPages   int32 `json:"total_pages"`
-	TotalResults int32 `json:"total_results"`
+	Page         int32             `json:"page"`
+	Results      []GuestRatedMovie `json:"results"`
+	TotalPages   int32             `json:"total_pages"`
+	TotalResults int32             `json:"total_results"`
+}
+
+func (r *RatedMoviesResponse) pageInfo() (page, totalPages int) {
+	return int(r.Page), int(r.TotalPages)
+}
+
+func (r *RatedMoviesResponse) results() []GuestRatedMovie {
+	return r.Results
+}
+
+type GuestRatedTvShow struct {
+	Adult            bool     `json:"adult"`
+	BackdropPath     string   `json:"backdrop_path"`
+	FirstAirDate     string   `json:"first_air_date"`
+	GenreIds         []int32  `json:"genre_ids"`
+	ID               int32    `json:"id"`
+	Name             string   `json:"name"`
+	OriginCountry    []string `json:"origin_country"`
+	OriginalLanguage string   `json:"original_language"`
+	OriginalName     string   `json:"original_name"`
+	Overview         string   `json:"overview"`
+	Popularity       float64  `json:"popularity"`
+	PosterPath       string   `json:"poster_path"`
+	VoteAverage      float64  `json:"vote_average"`
+	VoteCount        int32    `json:"vote_count"`
+	Rating           float64  `json:"rating"`
 }
 
 type RatedTvShowsResponse struct {
-	Page    int32 `json:"page"`
-	Results []struct {
-		Adult            bool    `json:"adult"`
-		BackdropPath     string  `json:"backdrop_path"`
-		GenreIds         []int32 `json:"genre_ids"`
-		ID               int32   `json:"id"`
-		OriginalLanguage string  `json:"original_language"`
-		OriginalTitle    string  `json:"original_title"`
-		Overview         string  `json:"overview"`
-		Popularity       float64 `json:"popularity"`
-		PosterPath       string  `json:"poster_path"`
-		ReleaseDate      string  `json:"release_date"`
-		Title            string  `json:"title"`
-		Video            bool    `json:"video"`
-		VoteAverage      float64 `json:"vote_average"`
-		VoteCount        int32   `json:"vote_count"`
-		Rating           float64 `json:"rating"`
-	} `json:"results"`
-	TotalPages   int32 `json:"total_pages"`
-	TotalResults int32 `json:"total_results"`
+	Page         int32              `json:"page"`
+	Results      []GuestRatedTvShow `json:"results"`
+	TotalPages   int32              `json:"total_pages"`
+	TotalResults int32              `json:"total_results"`
+}
+
+func (r *RatedTvShowsResponse) pageInfo() (page, totalPages int) {
+	return int(r.Page), int(r.TotalPages)
+}
+
+func (r *RatedTvShowsResponse) results() []GuestRatedTvShow {
+	return r.Results
+}
+
+type GuestRatedTvShowEpisode struct {
+	AirDate        string  `json:"air_date"`
+	EpisodeNumber  int32   `json:"episode_number"`
+	ID             int32   `json:"id"`
+	Name           string  `json:"name"`
+	Overview       string  `json:"overview"`
+	ProductionCode string  `json:"production_code"`
+	Runtime        int32   `json:"runtime"`
+	SeasonNumber   int32   `json:"season_number"`
+	ShowID         int32   `json:"show_id"`
+	StillPath      string  `json:"still_path"`
+	VoteAverage    float64 `json:"vote_average"`
+	VoteCount      int32   `json:"vote_count"`
+	Rating         float64 `json:"rating"`
 }
 
 type RatedTvShowEpisodesResponse struct {
-	Page    int32 `json:"page"`
-	Results []struct {
-		AirDate        string  `json:"air_date"`
-		EpisodeNumber  int32   `json:"episode_number"`
-		ID             int32   `json:"id"`
-		Name           string  `json:"name"`
-		Overview       string  `json:"overview"`
-		ProductionCode string  `json:"production_code"`
-		Runtime        int32   `json:"runtime"`
-		SeasonNumber   int32   `json:"season_number"`
-		ShowID         int32   `json:"show_id"`
-		StillPath      string  `json:"still_path"`
-		VoteAverage    float64 `json:"vote_average"`
-		VoteCount      int32   `json:"vote_count"`
-		Rating         float64 `json:"rating"`
-	} `json:"results"`
-	TotalPages   int32 `json:"total_pages"`
-	TotalResults int32 `json:"total_results"`
-}
-
-func (gc *GuestSessionsClient) GetRatedMovies(ctx context.Context, guestSessionId int32, queryParams ...queryParam) (*RatedMoviesResponse, error) {
-	resp, err := gc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/guest_session/%d/rated/movies", guestSessionId), queryParams...)
+	Page         int32                     `json:"page"`
+	Results      []GuestRatedTvShowEpisode `json:"results"`
+	TotalPages   int32                     `json:"total_pages"`
+	TotalResults int32                     `json:"total_results"`
+}
+
+func (r *RatedTvShowEpisodesResponse) pageInfo() (page, totalPages int) {
+	return int(r.Page), int(r.TotalPages)
+}
+
+func (r *RatedTvShowEpisodesResponse) results() []GuestRatedTvShowEpisode {
+	return r.Results
+}
+
+func (gc *GuestSessionsClient) GetRatedMovies(ctx context.Context, guestSessionId string, queryParams ...QueryParam) (*RatedMoviesResponse, error) {
+	resp, err := gc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/guest_session/%s/rated/movies", guestSessionId), queryParams...)
 	if err != nil {
 		return nil, err
 	}
@@ -98,8 +128,8 @@ func (gc *GuestSessionsClient) GetRatedMovies(ctx context.Context, guestSessionI
 	return &result, nil
 }
 
-func (gc *GuestSessionsClient) GetRatedTVShows(ctx context.Context, guestSessionId int32, queryParams ...queryParam) (*RatedTvShowsResponse, error) {
-	resp, err := gc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/guest_session/%d/rated/tv", guestSessionId), queryParams...)
+func (gc *GuestSessionsClient) GetRatedTVShows(ctx context.Context, guestSessionId string, queryParams ...QueryParam) (*RatedTvShowsResponse, error) {
+	resp, err := gc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/guest_session/%s/rated/tv", guestSessionId), queryParams...)
 	if err != nil {
 		return nil, err
 	}
@@ -112,8 +142,8 @@ func (gc *GuestSessionsClient) GetRatedTVShows(ctx context.Context, guestSession
 	return &result, nil
 }
 
-func (gc *GuestSessionsClient) GetRatedTVEpisodes(ctx context.Context, guestSessionId int32, queryParams ...queryParam) (*RatedTvShowEpisodesResponse, error) {
-	resp, err := gc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/guest_session/%d/rated/tv/episodes", guestSessionId), queryParams...)
+func (gc *GuestSessionsClient) GetRatedTVEpisodes(ctx context.Context, guestSessionId string, queryParams ...QueryParam) (*RatedTvShowEpisodesResponse, error) {
+	resp, err := gc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/guest_session/%s/rated/tv/episodes", guestSessionId), queryParams...)
 	if err != nil {
 		return nil, err
 	}
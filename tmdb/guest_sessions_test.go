@@ -0,0 +1,45 @@
+package tmdb
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGuestSessionsClient(t *testing.T) {
+	testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/3/guest_session/guest/rated/movies":
+			_, _ = w.Write([]byte(`{"page":1,"total_pages":1,"total_results":1,"results":[{"id":18148,"title":"Tokyo Story","original_title":"東京物語","release_date":"1953-11-03","video":false,"rating":9}]}`))
+		case "/3/guest_session/guest/rated/tv":
+			_, _ = w.Write([]byte(`{"page":1,"total_pages":1,"total_results":1,"results":[{"id":1396,"name":"Breaking Bad","original_name":"Breaking Bad","first_air_date":"2008-01-20","origin_country":["US"],"rating":8.5}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer testServer.Close()
+
+	t.Run("Rated movies", func(t *testing.T) {
+		result, err := testClient.GuestSessions.GetRatedMovies(context.Background(), "guest")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		movie := result.Results[0]
+		if movie.Title != "Tokyo Story" || movie.OriginalTitle != "東京物語" || movie.ReleaseDate != "1953-11-03" || movie.Rating != 9 {
+			t.Errorf("unexpected rated movie %+v", movie)
+		}
+	})
+
+	t.Run("Rated tv series", func(t *testing.T) {
+		result, err := testClient.GuestSessions.GetRatedTVShows(context.Background(), "guest")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		show := result.Results[0]
+		if show.Name != "Breaking Bad" || show.FirstAirDate != "2008-01-20" || len(show.OriginCountry) != 1 || show.Rating != 8.5 {
+			t.Errorf("unexpected rated tv series %+v", show)
+		}
+	})
+}
@@ -0,0 +1,148 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ImageKind is the kind of an image path, it decides the sizes TMDb serves the image in.
+type ImageKind string
+
+const (
+	ImagePoster   ImageKind = "poster"
+	ImageBackdrop ImageKind = "backdrop"
+	ImageLogo     ImageKind = "logo"
+	ImageProfile  ImageKind = "profile"
+	ImageStill    ImageKind = "still"
+)
+
+// ImageSizeOriginal serves an image in the size it was uploaded in, it is valid for every kind.
+const ImageSizeOriginal = "original"
+
+// defaultImagesTTL is how long Images keeps the configuration when no TTL is given.
+const defaultImagesTTL = 24 * time.Hour
+
+// Images builds the full URL of the image paths in responses, e.g. PosterPath or ProfilePath.
+// The base URL and sizes come from Configuration.GetDetails, which is fetched on first use and
+// kept for the TTL. Images is safe for concurrent use.
+type Images struct {
+	client    *Client
+	ttl       time.Duration
+	now       func() time.Time
+	mu        sync.Mutex
+	config    *ConfigurationDetails
+	fetchedAt time.Time
+}
+
+// NewImages returns an image URL builder keeping the configuration for ttl, defaults to 24 hours.
+func NewImages(client *Client, ttl time.Duration) *Images {
+	if ttl <= 0 {
+		ttl = defaultImagesTTL
+	}
+	return &Images{client: client, ttl: ttl, now: time.Now}
+}
+
+// URL returns the URL of the image at path in size, which is one of the sizes listed for kind,
+// such as w500, or ImageSizeOriginal. An empty path, as sent for missing images, returns an empty URL.
+func (i *Images) URL(ctx context.Context, kind ImageKind, size, path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+
+	config, err := i.configuration(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if size != ImageSizeOriginal && !containsString(imageSizes(config, kind), size) {
+		return "", fmt.Errorf("%w: %s images come in %s", ErrUnknownImageSize, kind, strings.Join(imageSizes(config, kind), ", "))
+	}
+	return imageURL(config, size, path), nil
+}
+
+// URLForWidth returns the URL of the image at path in the smallest size of kind that is at least
+// width pixels wide, or in ImageSizeOriginal when no listed size is wide enough.
+func (i *Images) URLForWidth(ctx context.Context, kind ImageKind, width int, path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+
+	config, err := i.configuration(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	size, best := ImageSizeOriginal, 0
+	for _, listed := range imageSizes(config, kind) {
+		// sizes like h632 are bounded by height, so they say nothing about the width
+		if !strings.HasPrefix(listed, "w") {
+			continue
+		}
+		w, err := strconv.Atoi(listed[1:])
+		if err != nil || w < width {
+			continue
+		}
+		if best == 0 || w < best {
+			size, best = listed, w
+		}
+	}
+	return imageURL(config, size, path), nil
+}
+
+// configuration returns the cached configuration, fetching it when missing or expired.
+// A stale configuration is used when refreshing it fails, image sizes rarely change.
+func (i *Images) configuration(ctx context.Context) (*ConfigurationDetails, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.config != nil && i.now().Sub(i.fetchedAt) < i.ttl {
+		return i.config, nil
+	}
+
+	config, err := i.client.Configuration.GetDetails(ctx)
+	if err != nil {
+		if i.config != nil {
+			return i.config, nil
+		}
+		return nil, err
+	}
+	i.config, i.fetchedAt = config, i.now()
+	return config, nil
+}
+
+func imageSizes(config *ConfigurationDetails, kind ImageKind) []string {
+	switch kind {
+	case ImagePoster:
+		return config.Images.PosterSizes
+	case ImageBackdrop:
+		return config.Images.BackdropSizes
+	case ImageLogo:
+		return config.Images.LogoSizes
+	case ImageProfile:
+		return config.Images.ProfileSizes
+	case ImageStill:
+		return config.Images.StillSizes
+	}
+	return nil
+}
+
+func imageURL(config *ConfigurationDetails, size, path string) string {
+	baseURL := config.Images.SecureBaseURL
+	if baseURL == "" {
+		baseURL = config.Images.BaseURL
+	}
+	return strings.TrimSuffix(baseURL, "/") + "/" + size + "/" + strings.TrimPrefix(path, "/")
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const configurationDetails = `{
+  "images": {
+    "base_url": "http://image.tmdb.org/t/p/",
+    "secure_base_url": "https://image.tmdb.org/t/p/",
+    "backdrop_sizes": ["w300", "w780", "w1280", "original"],
+    "logo_sizes": ["w45", "w92", "w154", "w185", "w300", "w500", "original"],
+    "poster_sizes": ["w92", "w154", "w185", "w342", "w500", "w780", "original"],
+    "profile_sizes": ["w45", "w185", "h632", "original"],
+    "still_sizes": ["w92", "w185", "w300", "original"]
+  },
+  "change_keys": []
+}`
+
+func TestImages(t *testing.T) {
+	var calls int32
+	testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		_, _ = w.Write([]byte(configurationDetails))
+	})
+	defer testServer.Close()
+
+	now := time.Now()
+	images := NewImages(testClient, time.Hour)
+	images.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	t.Run("URL", func(t *testing.T) {
+		url, err := images.URL(ctx, ImagePoster, "w500", "/g2YbTYKpY7N2yDSk7BfXZ18I5QV.jpg")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if url != "https://image.tmdb.org/t/p/w500/g2YbTYKpY7N2yDSk7BfXZ18I5QV.jpg" {
+			t.Errorf("unexpected url %s", url)
+		}
+
+		if url, _ := images.URL(ctx, ImageStill, ImageSizeOriginal, "/still.jpg"); url != "https://image.tmdb.org/t/p/original/still.jpg" {
+			t.Errorf("unexpected url %s", url)
+		}
+
+		if _, err := images.URL(ctx, ImageBackdrop, "w500", "/backdrop.jpg"); !errors.Is(err, ErrUnknownImageSize) {
+			t.Errorf("expected ErrUnknownImageSize, got %v", err)
+		}
+
+		if url, err := images.URL(ctx, ImagePoster, "w500", ""); url != "" || err != nil {
+			t.Errorf("expected no url for a missing image, got %q, %v", url, err)
+		}
+	})
+
+	t.Run("URL For Width", func(t *testing.T) {
+		expected := map[int]string{
+			100: "https://image.tmdb.org/t/p/w185/profile.jpg",
+			185: "https://image.tmdb.org/t/p/w185/profile.jpg",
+			400: "https://image.tmdb.org/t/p/original/profile.jpg",
+			0:   "https://image.tmdb.org/t/p/w45/profile.jpg",
+		}
+		for width, expectedURL := range expected {
+			url, err := images.URLForWidth(ctx, ImageProfile, width, "/profile.jpg")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if url != expectedURL {
+				t.Errorf("expected %s for width %d, got %s", expectedURL, width, url)
+			}
+		}
+	})
+
+	t.Run("Caches the configuration", func(t *testing.T) {
+		if calls != 1 {
+			t.Errorf("expected the configuration to be fetched once, got %d", calls)
+		}
+
+		now = now.Add(2 * time.Hour)
+		if _, err := images.URL(ctx, ImageLogo, "w45", "/logo.png"); err != nil {
+			t.Fatal(err)
+		}
+		if calls != 2 {
+			t.Errorf("expected the expired configuration to be fetched again, got %d", calls)
+		}
+	})
+}
@@ -0,0 +1,89 @@
+package tmdb
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// WithLogger makes the client emit one structured record per HTTP attempt.
+// The client is silent when no logger is set. Credentials are never logged.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// attemptLog describes a single HTTP attempt made by Client.request.
+type attemptLog struct {
+	method        string
+	path          string
+	query         string
+	attempt       int
+	status        int
+	latency       time.Duration
+	rateLimitWait time.Duration
+	err           error
+	retryIn       time.Duration
+	retrying      bool
+}
+
+// logAttempt writes a record for the attempt. Completed calls are logged at info level,
+// attempts that are about to be retried at warn level.
+func (c *Client) logAttempt(ctx context.Context, a attemptLog) {
+	if c.logger == nil {
+		return
+	}
+
+	level := slog.LevelInfo
+	msg := "tmdb request"
+	if a.retrying {
+		level = slog.LevelWarn
+		msg = "tmdb request failed, retrying"
+	}
+
+	if !c.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("service", serviceFromPath(a.path)),
+		slog.String("method", a.method),
+		slog.String("path", a.path),
+		slog.Int("attempt", a.attempt),
+		slog.Duration("latency", a.latency),
+		slog.Duration("rate_limit_wait", a.rateLimitWait),
+	}
+	if a.query != "" {
+		attrs = append(attrs, slog.String("query", redactQueryString(a.query)))
+	}
+	if a.status != 0 {
+		attrs = append(attrs, slog.Int("status", a.status))
+	}
+	if a.err != nil {
+		attrs = append(attrs, slog.String("error", c.redactError(a.err).Error()))
+	}
+	if a.retrying {
+		attrs = append(attrs, slog.Duration("retry_in", a.retryIn))
+	}
+
+	c.logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// serviceFromPath returns the TMDb resource a path belongs to, e.g. "movie" for /3/movie/550.
+func serviceFromPath(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) > 1 {
+		return segments[1]
+	}
+	return segments[0]
+}
+
+func statusOf(resp *http.Response) int {
+	if resp == nil {
+		return 0
+	}
+	return resp.StatusCode
+}
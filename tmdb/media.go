@@ -0,0 +1,163 @@
+package tmdb
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Media types TMDb tags the items of mixed media lists with.
+const (
+	MediaTypeMovie  = "movie"
+	MediaTypeTv     = "tv"
+	MediaTypePerson = "person"
+)
+
+// MediaResult is an item of a mixed media list, such as the results of a multi search.
+// It is one of *MovieResult, *TvResult, *PersonResult or, for media types this client
+// does not know yet, *UnknownResult:
+//
+//	for _, result := range response.Results {
+//		switch result := result.(type) {
+//		case *tmdb.MovieResult:
+//			fmt.Println(result.Title, result.ReleaseDate)
+//		case *tmdb.TvResult:
+//			fmt.Println(result.Name, result.FirstAirDate)
+//		case *tmdb.PersonResult:
+//			fmt.Println(result.Name, len(result.KnownFor))
+//		}
+//	}
+type MediaResult interface {
+	GetID() int32
+	GetMediaType() string
+}
+
+type MovieResult struct {
+	Adult            bool    `json:"adult"`
+	BackdropPath     string  `json:"backdrop_path"`
+	GenreIds         []int32 `json:"genre_ids"`
+	ID               int32   `json:"id"`
+	MediaType        string  `json:"media_type"`
+	OriginalLanguage string  `json:"original_language"`
+	OriginalTitle    string  `json:"original_title"`
+	Overview         string  `json:"overview"`
+	Popularity       float64 `json:"popularity"`
+	PosterPath       string  `json:"poster_path"`
+	ReleaseDate      string  `json:"release_date"`
+	Title            string  `json:"title"`
+	Video            bool    `json:"video"`
+	VoteAverage      float64 `json:"vote_average"`
+	VoteCount        int32   `json:"vote_count"`
+}
+
+func (r *MovieResult) GetID() int32 {
+	return r.ID
+}
+
+func (r *MovieResult) GetMediaType() string {
+	return MediaTypeMovie
+}
+
+type TvResult struct {
+	Adult            bool     `json:"adult"`
+	BackdropPath     string   `json:"backdrop_path"`
+	FirstAirDate     string   `json:"first_air_date"`
+	GenreIds         []int32  `json:"genre_ids"`
+	ID               int32    `json:"id"`
+	MediaType        string   `json:"media_type"`
+	Name             string   `json:"name"`
+	OriginCountry    []string `json:"origin_country"`
+	OriginalLanguage string   `json:"original_language"`
+	OriginalName     string   `json:"original_name"`
+	Overview         string   `json:"overview"`
+	Popularity       float64  `json:"popularity"`
+	PosterPath       string   `json:"poster_path"`
+	VoteAverage      float64  `json:"vote_average"`
+	VoteCount        int32    `json:"vote_count"`
+}
+
+func (r *TvResult) GetID() int32 {
+	return r.ID
+}
+
+func (r *TvResult) GetMediaType() string {
+	return MediaTypeTv
+}
+
+type PersonResult struct {
+	Adult              bool         `json:"adult"`
+	Gender             int32        `json:"gender"`
+	ID                 int32        `json:"id"`
+	KnownFor           MediaResults `json:"known_for"`
+	KnownForDepartment string       `json:"known_for_department"`
+	MediaType          string       `json:"media_type"`
+	Name               string       `json:"name"`
+	OriginalName       string       `json:"original_name"`
+	Popularity         float64      `json:"popularity"`
+	ProfilePath        string       `json:"profile_path"`
+}
+
+func (r *PersonResult) GetID() int32 {
+	return r.ID
+}
+
+func (r *PersonResult) GetMediaType() string {
+	return MediaTypePerson
+}
+
+// UnknownResult holds an item of a media type this client has no type for, Raw is the item as sent by TMDb.
+type UnknownResult struct {
+	ID        int32           `json:"id"`
+	MediaType string          `json:"media_type"`
+	Raw       json.RawMessage `json:"-"`
+}
+
+func (r *UnknownResult) GetID() int32 {
+	return r.ID
+}
+
+func (r *UnknownResult) GetMediaType() string {
+	return r.MediaType
+}
+
+func (r *UnknownResult) MarshalJSON() ([]byte, error) {
+	return r.Raw, nil
+}
+
+// MediaResults decodes a mixed media list into a MediaResult per item, picked by its media_type.
+type MediaResults []MediaResult
+
+func (m *MediaResults) UnmarshalJSON(data []byte) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+
+	results := make(MediaResults, 0, len(items))
+	for i, item := range items {
+		var tag struct {
+			MediaType string `json:"media_type"`
+		}
+		if err := json.Unmarshal(item, &tag); err != nil {
+			return fmt.Errorf("media result %d: %w", i, err)
+		}
+
+		var result MediaResult
+		switch tag.MediaType {
+		case MediaTypeMovie:
+			result = &MovieResult{}
+		case MediaTypeTv:
+			result = &TvResult{}
+		case MediaTypePerson:
+			result = &PersonResult{}
+		default:
+			result = &UnknownResult{Raw: item}
+		}
+		if err := json.Unmarshal(item, result); err != nil {
+			return fmt.Errorf("media result %d: %w", i, err)
+		}
+		results = append(results, result)
+	}
+
+	*m = results
+	return nil
+}
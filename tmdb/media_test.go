@@ -0,0 +1,81 @@
+package tmdb
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestMediaResults(t *testing.T) {
+	t.Run("Decodes multi search results by media type", func(t *testing.T) {
+		testClient, testServer, err := newTestClientAndServerWithFile(http.StatusOK, "testdata/get_search_multi.json")
+		defer testServer.Close()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		result, err := testClient.Search.GetMulti(context.Background(), SingleQueryParam{"query", "tokyo"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(result.Results) != 4 {
+			t.Fatalf("expected 4 results, got %d", len(result.Results))
+		}
+
+		movie, ok := result.Results[0].(*MovieResult)
+		if !ok || movie.Title != "Tokyo Story" || movie.ReleaseDate != "1953-11-03" {
+			t.Errorf("expected movie Tokyo Story, got %#v", result.Results[0])
+		}
+
+		tv, ok := result.Results[1].(*TvResult)
+		if !ok || tv.Name != "Tokyo Vice" || tv.FirstAirDate != "2022-04-07" {
+			t.Errorf("expected tv series Tokyo Vice, got %#v", result.Results[1])
+		}
+
+		person, ok := result.Results[2].(*PersonResult)
+		if !ok || person.Name != "Yasujirō Ozu" || len(person.KnownFor) != 1 {
+			t.Fatalf("expected person Yasujirō Ozu known for 1 movie, got %#v", result.Results[2])
+		}
+		if knownFor, ok := person.KnownFor[0].(*MovieResult); !ok || knownFor.ID != 18148 {
+			t.Errorf("expected person to be known for movie 18148, got %#v", person.KnownFor[0])
+		}
+
+		unknown, ok := result.Results[3].(*UnknownResult)
+		if !ok || unknown.GetMediaType() != "collection" || unknown.GetID() != 1 {
+			t.Errorf("expected unknown collection result, got %#v", result.Results[3])
+		}
+	})
+
+	t.Run("Round trips through JSON", func(t *testing.T) {
+		var results MediaResults
+		if err := json.Unmarshal([]byte(`[{"id":1,"media_type":"tv","name":"Tokyo Vice"},{"id":2,"media_type":"collection","name":"x"}]`), &results); err != nil {
+			t.Fatal(err)
+		}
+
+		data, err := json.Marshal(results)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var decoded MediaResults
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if tv, ok := decoded[0].(*TvResult); !ok || tv.Name != "Tokyo Vice" {
+			t.Errorf("expected tv series Tokyo Vice, got %#v", decoded[0])
+		}
+		if decoded[1].GetMediaType() != "collection" {
+			t.Errorf("expected collection, got %s", decoded[1].GetMediaType())
+		}
+	})
+
+	t.Run("Rejects malformed items", func(t *testing.T) {
+		var results MediaResults
+		if err := json.Unmarshal([]byte(`[{"id":"one","media_type":"movie"}]`), &results); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
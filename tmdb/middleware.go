@@ -0,0 +1,37 @@
+package tmdb
+
+import "net/http"
+
+// Doer sends an HTTP request and returns its response. *http.Client is a Doer.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc adapts an ordinary function to a Doer.
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the Doer used by the client to send every request, e.g. for tracing,
+// metrics, request signing or fault injection.
+type Middleware func(next Doer) Doer
+
+// WithMiddleware appends middlewares to the chain wrapped around the HTTP client.
+// The first middleware is the outermost one, so it sees the request first and the response last.
+// Middlewares run once per attempt, after the auth header or api key is set and before retries are decided.
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return func(c *Client) {
+		c.middlewares = append(c.middlewares, middlewares...)
+	}
+}
+
+// buildDoer wraps the HTTP client in the configured middlewares.
+func (c *Client) buildDoer() Doer {
+	var doer Doer = c.client
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		doer = c.middlewares[i](doer)
+	}
+	return doer
+}
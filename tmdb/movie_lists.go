@@ -7,10 +7,10 @@ import (
 )
 
 type MovieListsService interface {
-	GetNowPlaying(ctx context.Context, queryParams ...queryParam) (*MoviesNowPlayingResponse, error)
-	GetPopular(ctx context.Context, queryParams ...queryParam) (*MoviesPopularResponse, error)
-	GetTopRated(ctx context.Context, queryParams ...queryParam) (*MoviesTopRatedResponse, error)
-	GetUpcoming(ctx context.Context, queryParams ...queryParam) (*MoviesUpcomingResponse, error)
+	GetNowPlaying(ctx context.Context, queryParams ...QueryParam) (*MoviesNowPlayingResponse, error)
+	GetPopular(ctx context.Context, queryParams ...QueryParam) (*MoviesPopularResponse, error)
+	GetTopRated(ctx context.Context, queryParams ...QueryParam) (*MoviesTopRatedResponse, error)
+	GetUpcoming(ctx context.Context, queryParams ...QueryParam) (*MoviesUpcomingResponse, error)
 }
 
 type MovieListsClient struct {
@@ -45,6 +45,14 @@ type MoviesNowPlayingResponse struct {
 	TotalResults int              `json:"total_results"`
 }
 
+func (r *MoviesNowPlayingResponse) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *MoviesNowPlayingResponse) results() []MovieListMovie {
+	return r.Results
+}
+
 type MoviesPopularResponse struct {
 	Page         int              `json:"page"`
 	Results      []MovieListMovie `json:"results"`
@@ -52,6 +60,14 @@ type MoviesPopularResponse struct {
 	TotalResults int              `json:"total_results"`
 }
 
+func (r *MoviesPopularResponse) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *MoviesPopularResponse) results() []MovieListMovie {
+	return r.Results
+}
+
 type MoviesTopRatedResponse struct {
 	Page         int              `json:"page"`
 	Results      []MovieListMovie `json:"results"`
@@ -59,6 +75,14 @@ type MoviesTopRatedResponse struct {
 	TotalResults int              `json:"total_results"`
 }
 
+func (r *MoviesTopRatedResponse) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *MoviesTopRatedResponse) results() []MovieListMovie {
+	return r.Results
+}
+
 type MoviesUpcomingResponse struct {
 	Dates struct {
 		Maximum string `json:"maximum"`
@@ -70,7 +94,15 @@ type MoviesUpcomingResponse struct {
 	TotalResults int              `json:"total_results"`
 }
 
-func (mlc *MovieListsClient) GetNowPlaying(ctx context.Context, queryParams ...queryParam) (*MoviesNowPlayingResponse, error) {
+func (r *MoviesUpcomingResponse) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *MoviesUpcomingResponse) results() []MovieListMovie {
+	return r.Results
+}
+
+func (mlc *MovieListsClient) GetNowPlaying(ctx context.Context, queryParams ...QueryParam) (*MoviesNowPlayingResponse, error) {
 	resp, err := mlc.baseClient.request(ctx, http.MethodGet, "/movie/now_playing", queryParams...)
 	if err != nil {
 		return nil, err
@@ -84,7 +116,7 @@ func (mlc *MovieListsClient) GetNowPlaying(ctx context.Context, queryParams ...q
 	return &moviesNowPlayingResponse, nil
 }
 
-func (mlc *MovieListsClient) GetPopular(ctx context.Context, queryParams ...queryParam) (*MoviesPopularResponse, error) {
+func (mlc *MovieListsClient) GetPopular(ctx context.Context, queryParams ...QueryParam) (*MoviesPopularResponse, error) {
 	resp, err := mlc.baseClient.request(ctx, http.MethodGet, "/movie/popular", queryParams...)
 	if err != nil {
 		return nil, err
@@ -98,7 +130,7 @@ func (mlc *MovieListsClient) GetPopular(ctx context.Context, queryParams ...quer
 	return &moviesPopularResponse, nil
 }
 
-func (mlc *MovieListsClient) GetTopRated(ctx context.Context, queryParams ...queryParam) (*MoviesTopRatedResponse, error) {
+func (mlc *MovieListsClient) GetTopRated(ctx context.Context, queryParams ...QueryParam) (*MoviesTopRatedResponse, error) {
 	resp, err := mlc.baseClient.request(ctx, http.MethodGet, "/movie/top_rated", queryParams...)
 	if err != nil {
 		return nil, err
@@ -112,7 +144,7 @@ func (mlc *MovieListsClient) GetTopRated(ctx context.Context, queryParams ...que
 	return &moviesTopRatedResponse, nil
 }
 
-func (mlc *MovieListsClient) GetUpcoming(ctx context.Context, queryParams ...queryParam) (*MoviesUpcomingResponse, error) {
+func (mlc *MovieListsClient) GetUpcoming(ctx context.Context, queryParams ...QueryParam) (*MoviesUpcomingResponse, error) {
 	resp, err := mlc.baseClient.request(ctx, http.MethodGet, "/movie/upcoming", queryParams...)
 	if err != nil {
 		return nil, err
@@ -8,22 +8,25 @@ import (
 )
 
 type MoviesService interface {
-	GetDetails(ctx context.Context, movieID int, queryParams ...queryParam) (*MovieDetailsResponse, error)
-	GetAccountStates(ctx context.Context, movieID int, queryParams ...queryParam) (*MovieAccountStatesResponse, error)
-	GetAlternativeTitles(ctx context.Context, movieID int, queryParams ...queryParam) (*MovieAlternativeTitlesResponse, error)
-	GetChanges(ctx context.Context, movieID int, queryParams ...queryParam) (*MovieChangesResponse, error)
-	GetCredits(ctx context.Context, movieID int, queryParams ...queryParam) (*MovieCreditsResponse, error)
+	GetDetails(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieDetailsResponse, error)
+	GetDetailsWith(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieDetailsResponse, error)
+	GetAccountStates(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieAccountStatesResponse, error)
+	AddRating(ctx context.Context, movieID int, rating float64, queryParams ...QueryParam) (*WriteResponse, error)
+	DeleteRating(ctx context.Context, movieID int, queryParams ...QueryParam) (*WriteResponse, error)
+	GetAlternativeTitles(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieAlternativeTitlesResponse, error)
+	GetChanges(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieChangesResponse, error)
+	GetCredits(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieCreditsResponse, error)
 	GetExternalIDs(ctx context.Context, movieID int) (*MovieExternalIDsResponse, error)
-	GetImages(ctx context.Context, movieID int, queryParams ...queryParam) (*MovieImagesResponse, error)
+	GetImages(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieImagesResponse, error)
 	GetKeywords(ctx context.Context, movieID int) (*MovieKeywordsResponse, error)
 	GetLatest(ctx context.Context) (*MovieLatestResponse, error)
-	GetLists(ctx context.Context, movieID int, queryParams ...queryParam) (*MovieListsResponse, error)
-	GetRecommendations(ctx context.Context, movieID int, queryParams ...queryParam) (*MovieRecommendationsResponse, error)
+	GetLists(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieListsResponse, error)
+	GetRecommendations(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieRecommendationsResponse, error)
 	GetReleaseDates(ctx context.Context, movieID int) (*MovieReleaseDatesResponse, error)
-	GetReviews(ctx context.Context, movieID int, queryParams ...queryParam) (*MovieReviewsResponse, error)
-	GetSimilar(ctx context.Context, movieID int, queryParams ...queryParam) (*MovieSimilarMoviesResponse, error)
+	GetReviews(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieReviewsResponse, error)
+	GetSimilar(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieSimilarMoviesResponse, error)
 	GetTranslations(ctx context.Context, movieID int) (*MovieTranslationsResponse, error)
-	GetVideos(ctx context.Context, movieID int, queryParams ...queryParam) (*MovieVideosResponse, error)
+	GetVideos(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieVideosResponse, error)
 	GetWatchProviders(ctx context.Context, movieID int) (*MovieWatchProvidersResponse, error)
 }
 
@@ -72,6 +75,38 @@ type MovieDetailsResponse struct {
 	Video       bool    `json:"video"`
 	VoteAverage float64 `json:"vote_average"`
 	VoteCount   int     `json:"vote_count"`
+
+	// Appended sub-resources, only set when requested through GetDetailsWith.
+	AlternativeTitles *MovieAlternativeTitlesResponse `json:"alternative_titles,omitempty"`
+	Credits           *MovieCreditsResponse           `json:"credits,omitempty"`
+	ExternalIDs       *MovieExternalIDsResponse       `json:"external_ids,omitempty"`
+	Images            *MovieImagesResponse            `json:"images,omitempty"`
+	Keywords          *MovieKeywordsResponse          `json:"keywords,omitempty"`
+	Lists             *MovieListsResponse             `json:"lists,omitempty"`
+	Recommendations   *MovieRecommendationsResponse   `json:"recommendations,omitempty"`
+	ReleaseDates      *MovieReleaseDatesResponse      `json:"release_dates,omitempty"`
+	Reviews           *MovieReviewsResponse           `json:"reviews,omitempty"`
+	Similar           *MovieSimilarMoviesResponse     `json:"similar,omitempty"`
+	Translations      *MovieTranslationsResponse      `json:"translations,omitempty"`
+	Videos            *MovieVideosResponse            `json:"videos,omitempty"`
+	WatchProviders    *MovieWatchProvidersResponse    `json:"watch/providers,omitempty"`
+}
+
+// movieDetailsAppends are the sub-resources MovieDetailsResponse can hold.
+var movieDetailsAppends = []AppendToResponse{
+	AppendAlternativeTitles,
+	AppendCredits,
+	AppendExternalIDs,
+	AppendImages,
+	AppendKeywords,
+	AppendLists,
+	AppendRecommendations,
+	AppendReleaseDates,
+	AppendReviews,
+	AppendSimilar,
+	AppendTranslations,
+	AppendVideos,
+	AppendWatchProviders,
 }
 
 type MovieAccountStatesResponse struct {
@@ -92,9 +127,8 @@ type MovieAlternativeTitlesResponse struct {
 	} `json:"titles"`
 }
 
-// TODO: figure out this response
 type MovieChangesResponse struct {
-	Changes []struct{} `json:"changes"`
+	Changes []Change `json:"changes"`
 }
 
 type MovieCreditsResponse struct {
@@ -202,44 +236,64 @@ type MovieLatestResponse struct {
 	VoteCount   int     `json:"vote_count"`
 }
 
+type MovieList struct {
+	Description   string `json:"description"`
+	FavoriteCount int    `json:"favorite_count"`
+	ID            int    `json:"id"`
+	ItemCount     int    `json:"item_count"`
+	Iso_639_1     string `json:"iso_639_1"`
+	ListType      string `json:"list_type"`
+	Name          string `json:"name"`
+	PosterPath    string `json:"poster_path"`
+}
+
 type MovieListsResponse struct {
-	ID      int `json:"id"`
-	Page    int `json:"page"`
-	Results []struct {
-		Description   string `json:"description"`
-		FavoriteCount int    `json:"favorite_count"`
-		ID            int    `json:"id"`
-		ItemCount     int    `json:"item_count"`
-		Iso_639_1     string `json:"iso_639_1"`
-		ListType      string `json:"list_type"`
-		Name          string `json:"name"`
-		PosterPath    string `json:"poster_path"`
-	} `json:"results"`
-	TotalPages   int `json:"total_pages"`
-	TotalResults int `json:"total_results"`
+	ID           int         `json:"id"`
+	Page         int         `json:"page"`
+	Results      []MovieList `json:"results"`
+	TotalPages   int         `json:"total_pages"`
+	TotalResults int         `json:"total_results"`
+}
+
+func (r *MovieListsResponse) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *MovieListsResponse) results() []MovieList {
+	return r.Results
+}
+
+type MovieRecommendation struct {
+	Adult            bool    `json:"adult"`
+	BackdropPath     string  `json:"backdrop_path"`
+	GenreIds         []int   `json:"genre_ids"`
+	ID               int     `json:"id"`
+	MediaType        string  `json:"media_type"`
+	OriginalLanguage string  `json:"original_language"`
+	OriginalTitle    string  `json:"original_title"`
+	Overview         string  `json:"overview"`
+	Popularity       float64 `json:"popularity"`
+	PosterPath       string  `json:"poster_path"`
+	ReleaseDate      string  `json:"release_date"`
+	Title            string  `json:"title"`
+	Video            bool    `json:"video"`
+	VoteAverage      float64 `json:"vote_average"`
+	VoteCount        int     `json:"vote_count"`
 }
 
 type MovieRecommendationsResponse struct {
-	Page    int `json:"page"`
-	Results []struct {
-		Adult            bool    `json:"adult"`
-		BackdropPath     string  `json:"backdrop_path"`
-		GenreIds         []int   `json:"genre_ids"`
-		ID               int     `json:"id"`
-		MediaType        string  `json:"media_type"`
-		OriginalLanguage string  `json:"original_language"`
-		OriginalTitle    string  `json:"original_title"`
-		Overview         string  `json:"overview"`
-		Popularity       float64 `json:"popularity"`
-		PosterPath       string  `json:"poster_path"`
-		ReleaseDate      string  `json:"release_date"`
-		Title            string  `json:"title"`
-		Video            bool    `json:"video"`
-		VoteAverage      float64 `json:"vote_average"`
-		VoteCount        int     `json:"vote_count"`
-	} `json:"results"`
-	TotalPages   int `json:"total_pages"`
-	TotalResults int `json:"total_results"`
+	Page         int                   `json:"page"`
+	Results      []MovieRecommendation `json:"results"`
+	TotalPages   int                   `json:"total_pages"`
+	TotalResults int                   `json:"total_results"`
+}
+
+func (r *MovieRecommendationsResponse) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *MovieRecommendationsResponse) results() []MovieRecommendation {
+	return r.Results
 }
 
 type MovieReleaseDatesResponse struct {
@@ -257,47 +311,67 @@ type MovieReleaseDatesResponse struct {
 	} `json:"results"`
 }
 
+type MovieReview struct {
+	Author        string `json:"author"`
+	AuthorDetails struct {
+		Name       string  `json:"name"`
+		Username   string  `json:"username"`
+		AvatarPath string  `json:"avatar_path"`
+		Rating     float64 `json:"rating"`
+	} `json:"author_details"`
+	Content   string `json:"content"`
+	CreatedAt string `json:"created_at"`
+	ID        string `json:"id"`
+	UpdatedAt string `json:"updated_at"`
+	URL       string `json:"url"`
+}
+
 type MovieReviewsResponse struct {
-	ID      int `json:"id"`
-	Page    int `json:"page"`
-	Results []struct {
-		Author        string `json:"author"`
-		AuthorDetails struct {
-			Name       string  `json:"name"`
-			Username   string  `json:"username"`
-			AvatarPath string  `json:"avatar_path"`
-			Rating     float64 `json:"rating"`
-		} `json:"author_details"`
-		Content   string `json:"content"`
-		CreatedAt string `json:"created_at"`
-		ID        string `json:"id"`
-		UpdatedAt string `json:"updated_at"`
-		URL       string `json:"url"`
-	} `json:"results"`
-	TotalPages   int `json:"total_pages"`
-	TotalResults int `json:"total_results"`
+	ID           int           `json:"id"`
+	Page         int           `json:"page"`
+	Results      []MovieReview `json:"results"`
+	TotalPages   int           `json:"total_pages"`
+	TotalResults int           `json:"total_results"`
+}
+
+func (r *MovieReviewsResponse) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *MovieReviewsResponse) results() []MovieReview {
+	return r.Results
+}
+
+type SimilarMovie struct {
+	Adult            bool    `json:"adult"`
+	BackdropPath     string  `json:"backdrop_path"`
+	GenreIDS         []int   `json:"genre_ids"`
+	ID               int     `json:"id"`
+	OriginalLanguage string  `json:"original_language"`
+	OriginalTitle    string  `json:"original_title"`
+	Overview         string  `json:"overview"`
+	Popularity       float64 `json:"popularity"`
+	PosterPath       string  `json:"poster_path"`
+	ReleaseDate      string  `json:"release_date"`
+	Title            string  `json:"title"`
+	Video            bool    `json:"video"`
+	VoteAverage      float64 `json:"vote_average"`
+	VoteCount        int     `json:"vote_count"`
 }
 
 type MovieSimilarMoviesResponse struct {
-	Page    int `json:"page"`
-	Results []struct {
-		Adult            bool    `json:"adult"`
-		BackdropPath     string  `json:"backdrop_path"`
-		GenreIDS         []int   `json:"genre_ids"`
-		ID               int     `json:"id"`
-		OriginalLanguage string  `json:"original_language"`
-		OriginalTitle    string  `json:"original_title"`
-		Overview         string  `json:"overview"`
-		Popularity       float64 `json:"popularity"`
-		PosterPath       string  `json:"poster_path"`
-		ReleaseDate      string  `json:"release_date"`
-		Title            string  `json:"title"`
-		Video            bool    `json:"video"`
-		VoteAverage      float64 `json:"vote_average"`
-		VoteCount        int     `json:"vote_count"`
-	} `json:"results"`
-	TotalPages   int `json:"total_pages"`
-	TotalResults int `json:"total_results"`
+	Page         int            `json:"page"`
+	Results      []SimilarMovie `json:"results"`
+	TotalPages   int            `json:"total_pages"`
+	TotalResults int            `json:"total_results"`
+}
+
+func (r *MovieSimilarMoviesResponse) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *MovieSimilarMoviesResponse) results() []SimilarMovie {
+	return r.Results
 }
 
 type MovieTranslationsResponse struct {
@@ -329,7 +403,7 @@ type MovieVideosResponse struct {
 		Size        int    `json:"size"`
 		Type        string `json:"type"`
 		Official    bool   `json:"official"`
-		PublishedAt bool   `json:"published_at"`
+		PublishedAt string `json:"published_at"`
 	} `json:"results"`
 }
 
@@ -829,7 +903,7 @@ type MovieWatchProvidersResponse struct {
 	} `json:"results"`
 }
 
-func (c *MoviesClient) GetDetails(ctx context.Context, movieID int, queryParams ...queryParam) (*MovieDetailsResponse, error) {
+func (c *MoviesClient) GetDetails(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieDetailsResponse, error) {
 	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/movie/%d", movieID), queryParams...)
 	if err != nil {
 		return nil, err
@@ -843,7 +917,16 @@ func (c *MoviesClient) GetDetails(ctx context.Context, movieID int, queryParams
 	return &details, nil
 }
 
-func (c *MoviesClient) GetAccountStates(ctx context.Context, movieID int, queryParams ...queryParam) (*MovieAccountStatesResponse, error) {
+// GetDetailsWith fetches the details of a movie together with the given AppendToResponse
+// sub-resources in a single request. Other query params, e.g. language, can be mixed in.
+func (c *MoviesClient) GetDetailsWith(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieDetailsResponse, error) {
+	if err := checkAppends("movie details", movieDetailsAppends, queryParams); err != nil {
+		return nil, err
+	}
+	return c.GetDetails(ctx, movieID, queryParams...)
+}
+
+func (c *MoviesClient) GetAccountStates(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieAccountStatesResponse, error) {
 	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/movie/%d/account_states", movieID), queryParams...)
 	if err != nil {
 		return nil, err
@@ -857,8 +940,8 @@ func (c *MoviesClient) GetAccountStates(ctx context.Context, movieID int, queryP
 	return &states, nil
 }
 
-func (c *MoviesClient) GetAlternativeTitles(ctx context.Context, movieID int, queryParams ...queryParam) (*MovieAlternativeTitlesResponse, error) {
-	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/movie/%d/alternative_titles", movieID))
+func (c *MoviesClient) GetAlternativeTitles(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieAlternativeTitlesResponse, error) {
+	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/movie/%d/alternative_titles", movieID), queryParams...)
 	if err != nil {
 		return nil, err
 	}
@@ -871,8 +954,8 @@ func (c *MoviesClient) GetAlternativeTitles(ctx context.Context, movieID int, qu
 	return &titles, nil
 }
 
-func (c *MoviesClient) GetChanges(ctx context.Context, movieID int, queryParams ...queryParam) (*MovieChangesResponse, error) {
-	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/movie/%d/changes", movieID))
+func (c *MoviesClient) GetChanges(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieChangesResponse, error) {
+	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/movie/%d/changes", movieID), queryParams...)
 	if err != nil {
 		return nil, err
 	}
@@ -885,8 +968,8 @@ func (c *MoviesClient) GetChanges(ctx context.Context, movieID int, queryParams
 	return &changes, nil
 }
 
-func (c *MoviesClient) GetCredits(ctx context.Context, movieID int, queryParams ...queryParam) (*MovieCreditsResponse, error) {
-	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/movie/%d/credits", movieID))
+func (c *MoviesClient) GetCredits(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieCreditsResponse, error) {
+	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/movie/%d/credits", movieID), queryParams...)
 	if err != nil {
 		return nil, err
 	}
@@ -913,8 +996,8 @@ func (c *MoviesClient) GetExternalIDs(ctx context.Context, movieID int) (*MovieE
 	return &ids, nil
 }
 
-func (c *MoviesClient) GetImages(ctx context.Context, movieID int, queryParams ...queryParam) (*MovieImagesResponse, error) {
-	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/movie/%d/images", movieID))
+func (c *MoviesClient) GetImages(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieImagesResponse, error) {
+	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/movie/%d/images", movieID), queryParams...)
 	if err != nil {
 		return nil, err
 	}
@@ -955,8 +1038,8 @@ func (c *MoviesClient) GetLatest(ctx context.Context) (*MovieLatestResponse, err
 	return &latest, nil
 }
 
-func (c *MoviesClient) GetLists(ctx context.Context, movieID int, queryParams ...queryParam) (*MovieListsResponse, error) {
-	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/movie/%d/lists", movieID))
+func (c *MoviesClient) GetLists(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieListsResponse, error) {
+	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/movie/%d/lists", movieID), queryParams...)
 	if err != nil {
 		return nil, err
 	}
@@ -969,8 +1052,8 @@ func (c *MoviesClient) GetLists(ctx context.Context, movieID int, queryParams ..
 	return &lists, nil
 }
 
-func (c *MoviesClient) GetRecommendations(ctx context.Context, movieID int, queryParams ...queryParam) (*MovieRecommendationsResponse, error) {
-	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/movie/%d/recommendations", movieID))
+func (c *MoviesClient) GetRecommendations(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieRecommendationsResponse, error) {
+	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/movie/%d/recommendations", movieID), queryParams...)
 	if err != nil {
 		return nil, err
 	}
@@ -997,8 +1080,8 @@ func (c *MoviesClient) GetReleaseDates(ctx context.Context, movieID int) (*Movie
 	return &dates, nil
 }
 
-func (c *MoviesClient) GetReviews(ctx context.Context, movieID int, queryParams ...queryParam) (*MovieReviewsResponse, error) {
-	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/movie/%d/reviews", movieID))
+func (c *MoviesClient) GetReviews(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieReviewsResponse, error) {
+	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/movie/%d/reviews", movieID), queryParams...)
 	if err != nil {
 		return nil, err
 	}
@@ -1011,8 +1094,8 @@ func (c *MoviesClient) GetReviews(ctx context.Context, movieID int, queryParams
 	return &reviews, nil
 }
 
-func (c *MoviesClient) GetSimilar(ctx context.Context, movieID int, queryParams ...queryParam) (*MovieSimilarMoviesResponse, error) {
-	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/movie/%d/similar", movieID))
+func (c *MoviesClient) GetSimilar(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieSimilarMoviesResponse, error) {
+	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/movie/%d/similar", movieID), queryParams...)
 	if err != nil {
 		return nil, err
 	}
@@ -1039,8 +1122,8 @@ func (c *MoviesClient) GetTranslations(ctx context.Context, movieID int) (*Movie
 	return &translations, nil
 }
 
-func (c *MoviesClient) GetVideos(ctx context.Context, movieID int, queryParams ...queryParam) (*MovieVideosResponse, error) {
-	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/movie/%d/videos", movieID))
+func (c *MoviesClient) GetVideos(ctx context.Context, movieID int, queryParams ...QueryParam) (*MovieVideosResponse, error) {
+	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/movie/%d/videos", movieID), queryParams...)
 	if err != nil {
 		return nil, err
 	}
@@ -1066,3 +1149,22 @@ func (c *MoviesClient) GetWatchProviders(ctx context.Context, movieID int) (*Mov
 	}
 	return &providers, nil
 }
+
+// AddRating rates the movie between 0.5 and 10 in steps of 0.5, as the user of a SessionID or GuestSessionID.
+func (c *MoviesClient) AddRating(ctx context.Context, movieID int, rating float64, queryParams ...QueryParam) (*WriteResponse, error) {
+	if err := validateRating(rating); err != nil {
+		return nil, err
+	}
+	if err := c.baseClient.requireSession(queryParams, "session_id", "guest_session_id"); err != nil {
+		return nil, err
+	}
+	return c.baseClient.write(ctx, http.MethodPost, fmt.Sprintf("/movie/%d/rating", movieID), ratingBody{Value: rating}, queryParams...)
+}
+
+// DeleteRating removes the rating of the movie by the user of a SessionID or GuestSessionID.
+func (c *MoviesClient) DeleteRating(ctx context.Context, movieID int, queryParams ...QueryParam) (*WriteResponse, error) {
+	if err := c.baseClient.requireSession(queryParams, "session_id", "guest_session_id"); err != nil {
+		return nil, err
+	}
+	return c.baseClient.write(ctx, http.MethodDelete, fmt.Sprintf("/movie/%d/rating", movieID), nil, queryParams...)
+}
@@ -2,7 +2,11 @@ package tmdb
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"net/http"
+	"net/url"
+	"os"
 	"testing"
 )
 
@@ -179,7 +183,7 @@ func TestMoviesClient(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		result, err := testClient.Movies.GetRecommendations(context.Background(), 18148, SingleQueryParam{"page", "0"}, SingleQueryParam{"language", "en-US"})
+		result, err := testClient.Movies.GetRecommendations(context.Background(), 18148, SingleQueryParam{"page", "1"}, SingleQueryParam{"language", "en-US"})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -205,7 +209,7 @@ func TestMoviesClient(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		result, err := testClient.Movies.GetReviews(context.Background(), 18148, SingleQueryParam{"page", "0"}, SingleQueryParam{"language", "en-US"})
+		result, err := testClient.Movies.GetReviews(context.Background(), 18148, SingleQueryParam{"page", "1"}, SingleQueryParam{"language", "en-US"})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -239,7 +243,7 @@ func TestMoviesClient(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		result, err := testClient.Movies.GetSimilar(context.Background(), 18148, SingleQueryParam{"page", "0"}, SingleQueryParam{"language", "en-US"})
+		result, err := testClient.Movies.GetSimilar(context.Background(), 18148, SingleQueryParam{"page", "1"}, SingleQueryParam{"language", "en-US"})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -256,4 +260,131 @@ func TestMoviesClient(t *testing.T) {
 			t.Errorf("expected 212929 total results, got %d", result.TotalResults)
 		}
 	})
+
+	t.Run("Get Details Tokyo Story With Appended Sub-Resources", func(t *testing.T) {
+		body, err := os.ReadFile("testdata/get_movie_details_appended.json")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var appended string
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			appended = r.URL.Query().Get("append_to_response")
+			_, _ = w.Write(body)
+		})
+		defer testServer.Close()
+
+		result, err := testClient.Movies.GetDetailsWith(context.Background(), 18148, AppendCredits, AppendExternalIDs, AppendVideos, AppendCredits, SingleQueryParam{"language", "en-US"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if appended != "credits,external_ids,videos" {
+			t.Errorf("expected append_to_response credits,external_ids,videos, got %s", appended)
+		}
+
+		if result.Title != TokyoStoryName {
+			t.Errorf("expected movie title Tokyo Story, got %s", result.Title)
+		}
+
+		if result.Credits == nil || result.Credits.Crew[0].Job != "Director" {
+			t.Errorf("expected appended credits with the director, got %+v", result.Credits)
+		}
+
+		if result.ExternalIDs == nil || result.ExternalIDs.WikidataID != "Q861734" {
+			t.Errorf("expected appended external ids, got %+v", result.ExternalIDs)
+		}
+
+		if result.Videos == nil || result.Videos.Results[0].PublishedAt != "2021-04-06T16:00:05.000Z" {
+			t.Errorf("expected appended videos, got %+v", result.Videos)
+		}
+
+		if result.Images != nil {
+			t.Errorf("expected images to be nil when not appended, got %+v", result.Images)
+		}
+	})
+
+	t.Run("Get Details With Appended Images In Other Languages", func(t *testing.T) {
+		var query url.Values
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			query = r.URL.Query()
+			_, _ = w.Write([]byte(`{"id":18148,"images":{"backdrops":[],"logos":[],"posters":[]},"videos":{"results":[]}}`))
+		})
+		defer testServer.Close()
+
+		result, err := testClient.Movies.GetDetailsWith(context.Background(), 18148, AppendImages, AppendVideos,
+			SingleQueryParam{"include_image_language", "ja,null"},
+			SingleQueryParam{"include_video_language", "ja"},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if query.Get("include_image_language") != "ja,null" || query.Get("include_video_language") != "ja" {
+			t.Errorf("expected the image and video languages to be sent, got %s", query.Encode())
+		}
+
+		if result.Images == nil || result.Videos == nil {
+			t.Errorf("expected appended images and videos, got %+v", result)
+		}
+	})
+
+	t.Run("Get Details With Unsupported Sub-Resource", func(t *testing.T) {
+		var calls int
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+		})
+		defer testServer.Close()
+
+		_, err := testClient.Movies.GetDetailsWith(context.Background(), 18148, AppendCredits, AppendTVCredits)
+		if !errors.Is(err, ErrInvalidQueryParams) {
+			t.Errorf("expected ErrInvalidQueryParams, got %v", err)
+		}
+
+		if calls != 0 {
+			t.Errorf("expected no request to be sent, got %d", calls)
+		}
+	})
+
+	t.Run("Get Changes Tokyo Story", func(t *testing.T) {
+		testClient, testServer, err := newTestClientAndServerWithFile(http.StatusOK, "testdata/get_movies_changes.json")
+		defer testServer.Close()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		result, err := testClient.Movies.GetChanges(context.Background(), 18148, SingleQueryParam{"start_date", "2024-01-10"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(result.Changes) != 4 {
+			t.Fatalf("expected 4 changes, got %d", len(result.Changes))
+		}
+
+		title := result.Changes[0].Items[0]
+		if title.Action != ChangeActionUpdated || title.Value != "Die Reise nach Tokio" || title.OriginalValue != "Reise nach Tokio" {
+			t.Errorf("expected title to be updated to Die Reise nach Tokio, got %+v", title)
+		}
+
+		images, ok := result.Changes[1].Items[0].Value.(ChangeImages)
+		if !ok || images["poster"].FilePath != "/g2YbTYKpY7N2yDSk7BfXZ18I5QV.jpg" {
+			t.Errorf("expected an added poster, got %#v", result.Changes[1].Items[0].Value)
+		}
+
+		cast := result.Changes[2].Items[0]
+		if credit, ok := cast.OriginalValue.(ChangeCredit); !ok || credit.PersonID != 95505 || cast.Value != nil {
+			t.Errorf("expected a deleted cast credit of person 95505, got %+v", cast)
+		}
+
+		// values not matching their key are kept as sent
+		budget := result.Changes[3].Items[0]
+		if raw, ok := budget.Value.(json.RawMessage); !ok || string(raw) != `"unknown"` {
+			t.Errorf("expected the raw budget value, got %#v", budget.Value)
+		}
+		if budget.OriginalValue != int64(0) {
+			t.Errorf("expected original budget 0, got %#v", budget.OriginalValue)
+		}
+	})
 }
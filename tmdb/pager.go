@@ -0,0 +1,142 @@
+package tmdb
+
+import "context"
+
+// maxPage is the last page TMDb serves for any paginated endpoint, whatever total_pages says.
+const maxPage = 500
+
+// paged is implemented by every paginated response.
+type paged[T any] interface {
+	pageInfo() (page, totalPages int)
+	results() []T
+}
+
+// PagerOptions limits how far a Pager walks. The zero value walks every page TMDb serves.
+type PagerOptions struct {
+	// StartPage is the first page fetched, defaults to 1.
+	StartPage int
+	// MaxPages stops the pager after fetching this many pages, 0 means no limit.
+	MaxPages int
+	// MaxItems stops the pager after yielding this many items, 0 means no limit.
+	MaxItems int
+}
+
+// Pager walks through the results of a paginated endpoint, fetching the next page
+// only once the items of the current one are used up:
+//
+//	pager := tmdb.NewPager(client.Search.GetMovie, tmdb.PagerOptions{MaxPages: 3},
+//		tmdb.SingleQueryParam{Key: "query", Value: "tokyo"})
+//	for pager.Next(ctx) {
+//		movie := pager.Item()
+//	}
+//	if err := pager.Err(); err != nil {
+//		// handle error here
+//	}
+type Pager[T any] struct {
+	fetch      func(ctx context.Context, page int) ([]T, int, error)
+	opts       PagerOptions
+	page       int
+	totalPages int
+	fetched    int
+	yielded    int
+	items      []T
+	item       T
+	err        error
+}
+
+// NewPager returns a Pager over a paginated endpoint such as client.Search.GetMovie.
+// The page query param is managed by the pager, use PagerOptions.StartPage to skip pages.
+func NewPager[T any, P paged[T]](fn func(context.Context, ...QueryParam) (P, error), opts PagerOptions, queryParams ...QueryParam) *Pager[T] {
+	return newPager[T](func(ctx context.Context, page int) (P, error) {
+		return fn(ctx, withPage(queryParams, page)...)
+	}, opts)
+}
+
+// NewPagerWithID returns a Pager over a paginated endpoint taking an identifier before its
+// query params, such as client.Movies.GetReviews or client.Trending.GetMovies.
+func NewPagerWithID[T any, P paged[T], K any](fn func(context.Context, K, ...QueryParam) (P, error), id K, opts PagerOptions, queryParams ...QueryParam) *Pager[T] {
+	return newPager[T](func(ctx context.Context, page int) (P, error) {
+		return fn(ctx, id, withPage(queryParams, page)...)
+	}, opts)
+}
+
+func newPager[T any, P paged[T]](fetch func(ctx context.Context, page int) (P, error), opts PagerOptions) *Pager[T] {
+	page := opts.StartPage
+	if page < 1 {
+		page = 1
+	}
+	return &Pager[T]{
+		fetch: func(ctx context.Context, page int) ([]T, int, error) {
+			resp, err := fetch(ctx, page)
+			if err != nil {
+				return nil, 0, err
+			}
+			_, totalPages := resp.pageInfo()
+			return resp.results(), totalPages, nil
+		},
+		opts:       opts,
+		page:       page,
+		totalPages: maxPage,
+	}
+}
+
+// withPage returns a copy of queryParams with the page param set last, so it wins.
+func withPage(queryParams []QueryParam, page int) []QueryParam {
+	params := make([]QueryParam, 0, len(queryParams)+1)
+	params = append(params, queryParams...)
+	return append(params, SingleQueryParam{Key: "page", Value: page})
+}
+
+// Next advances to the next item, fetching the next page when needed. It returns false once
+// the results or the limits are exhausted, or a request failed; check Err to tell them apart.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	if p.err != nil || (p.opts.MaxItems > 0 && p.yielded >= p.opts.MaxItems) {
+		return false
+	}
+
+	for len(p.items) == 0 {
+		if !p.hasNextPage() {
+			return false
+		}
+
+		items, totalPages, err := p.fetch(ctx, p.page)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		p.page++
+		p.fetched++
+		p.totalPages = totalPages
+		p.items = items
+	}
+
+	p.item, p.items = p.items[0], p.items[1:]
+	p.yielded++
+	return true
+}
+
+func (p *Pager[T]) hasNextPage() bool {
+	if p.opts.MaxPages > 0 && p.fetched >= p.opts.MaxPages {
+		return false
+	}
+	return p.page <= p.totalPages && p.page <= maxPage
+}
+
+// Item returns the current item, valid after Next returned true.
+func (p *Pager[T]) Item() T {
+	return p.item
+}
+
+// Err returns the error that stopped the pager, if any.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// All walks the remaining items and returns them, along with the items read before an error.
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	var items []T
+	for p.Next(ctx) {
+		items = append(items, p.Item())
+	}
+	return items, p.Err()
+}
@@ -0,0 +1,107 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+// newPagedServer serves totalPages pages of two movies each, the IDs encoding page and position.
+func newPagedServer(totalPages int, calls *int32) (*Client, func()) {
+	testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		_, _ = fmt.Fprintf(w, `{"page":%d,"total_pages":%d,"total_results":%d,"results":[{"id":%d},{"id":%d}]}`,
+			page, totalPages, totalPages*2, page*10+1, page*10+2)
+	})
+	return testClient, testServer.Close
+}
+
+func TestPager(t *testing.T) {
+	t.Run("Walks every page", func(t *testing.T) {
+		var calls int32
+		testClient, closeServer := newPagedServer(3, &calls)
+		defer closeServer()
+
+		pager := NewPager(testClient.Search.GetMovie, PagerOptions{}, SingleQueryParam{"query", "tokyo"})
+		var ids []int32
+		for pager.Next(context.Background()) {
+			ids = append(ids, pager.Item().ID)
+		}
+		if err := pager.Err(); err != nil {
+			t.Fatal(err)
+		}
+
+		if fmt.Sprint(ids) != "[11 12 21 22 31 32]" {
+			t.Errorf("expected items of 3 pages, got %v", ids)
+		}
+
+		if calls != 3 {
+			t.Errorf("expected 3 calls, got %d", calls)
+		}
+	})
+
+	t.Run("Stops at max items and max pages", func(t *testing.T) {
+		var calls int32
+		testClient, closeServer := newPagedServer(10, &calls)
+		defer closeServer()
+
+		items, err := NewPager(testClient.Discover.GetMovies, PagerOptions{MaxItems: 3}).All(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(items) != 3 || calls != 2 {
+			t.Errorf("expected 3 items from 2 calls, got %d items from %d calls", len(items), calls)
+		}
+
+		calls = 0
+		items, err = NewPager(testClient.Discover.GetMovies, PagerOptions{StartPage: 4, MaxPages: 2}).All(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(items) != 4 || items[0].ID != 41 || calls != 2 {
+			t.Errorf("expected 4 items starting at page 4 from 2 calls, got %+v from %d calls", items, calls)
+		}
+	})
+
+	t.Run("Respects the 500 page ceiling", func(t *testing.T) {
+		var calls int32
+		testClient, closeServer := newPagedServer(1000, &calls)
+		defer closeServer()
+
+		items, err := NewPagerWithID(testClient.Trending.GetMovies, "week", PagerOptions{StartPage: 499}).All(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 4 || calls != 2 {
+			t.Errorf("expected pages 499 and 500 only, got %d items from %d calls", len(items), calls)
+		}
+	})
+
+	t.Run("Stops on errors", func(t *testing.T) {
+		var calls int32
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) > 1 {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"status_code":34,"status_message":"The resource you requested could not be found."}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"id":18148,"page":1,"total_pages":2,"results":[{"id":"5d6f5c1b"}]}`))
+		})
+		defer testServer.Close()
+
+		items, err := NewPagerWithID(testClient.Movies.GetReviews, 18148, PagerOptions{}).All(context.Background())
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("expected ErrNotFound, got %v", err)
+		}
+
+		if len(items) != 1 || items[0].ID != "5d6f5c1b" {
+			t.Errorf("expected the items of the first page, got %+v", items)
+		}
+	})
+}
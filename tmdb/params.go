@@ -0,0 +1,397 @@
+package tmdb
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// paramKind is the type of value a query param accepts.
+type paramKind int
+
+const (
+	paramString paramKind = iota
+	paramInt
+	paramPage
+	paramFloat
+	paramBool
+	paramDate
+	paramLanguage
+	paramCountry
+	paramIDs
+	paramExternalSource
+)
+
+var (
+	languagePattern = regexp.MustCompile(`^[a-z]{2}(-[A-Z]{2})?$`)
+	countryPattern  = regexp.MustCompile(`^[A-Z]{2}$`)
+	idsPattern      = regexp.MustCompile(`^\d+([,|]\d+)*$`)
+)
+
+// check returns a description of the expected value when value does not fit the kind.
+func (k paramKind) check(value string) (expected string, ok bool) {
+	switch k {
+	case paramInt:
+		_, err := strconv.Atoi(value)
+		return "an integer", err == nil
+	case paramPage:
+		page, err := strconv.Atoi(value)
+		return fmt.Sprintf("between 1 and %d", maxPage), err == nil && page >= 1 && page <= maxPage
+	case paramFloat:
+		_, err := strconv.ParseFloat(value, 64)
+		return "a number", err == nil
+	case paramBool:
+		return "true or false", value == "true" || value == "false"
+	case paramDate:
+		_, err := time.Parse(time.DateOnly, value)
+		return "a YYYY-MM-DD date", err == nil
+	case paramLanguage:
+		return "an ISO 639-1 language like en or en-US", languagePattern.MatchString(value)
+	case paramCountry:
+		return "an ISO 3166-1 country code like US", countryPattern.MatchString(value)
+	case paramIDs:
+		return "IDs separated by , or |", idsPattern.MatchString(value)
+	case paramExternalSource:
+		return "an external source like imdb_id", ExternalSource(value).Validate() == nil
+	default:
+		return "", true
+	}
+}
+
+// paramSchema lists the query params an endpoint accepts.
+type paramSchema map[string]paramKind
+
+// with returns a copy of the schema extended by others.
+func (s paramSchema) with(others ...paramSchema) paramSchema {
+	merged := make(paramSchema, len(s))
+	for key, kind := range s {
+		merged[key] = kind
+	}
+	for _, other := range others {
+		for key, kind := range other {
+			merged[key] = kind
+		}
+	}
+	return merged
+}
+
+var (
+	noParams          = paramSchema{}
+	languageParams    = paramSchema{"language": paramLanguage}
+	pagedParams       = paramSchema{"language": paramLanguage, "page": paramPage}
+	detailsParams     = imageParams.with(videoParams, paramSchema{"append_to_response": paramString})
+	imageParams       = paramSchema{"language": paramLanguage, "include_image_language": paramString}
+	videoParams       = paramSchema{"language": paramLanguage, "include_video_language": paramString}
+	changesParams     = paramSchema{"start_date": paramDate, "end_date": paramDate, "page": paramPage}
+	accountStateParam = paramSchema{"session_id": paramString, "guest_session_id": paramString}
+	accountListParams = pagedParams.with(paramSchema{"session_id": paramString, "sort_by": paramString})
+	guestListParams   = pagedParams.with(paramSchema{"sort_by": paramString})
+	searchParams      = pagedParams.with(paramSchema{"query": paramString, "include_adult": paramBool})
+	v4AccountParams   = pagedParams.with(paramSchema{"sort_by": paramString})
+
+	discoverParams = pagedParams.with(paramSchema{
+		"sort_by":                       paramString,
+		"include_adult":                 paramBool,
+		"vote_average.gte":              paramFloat,
+		"vote_average.lte":              paramFloat,
+		"vote_count.gte":                paramFloat,
+		"vote_count.lte":                paramFloat,
+		"with_runtime.gte":              paramFloat,
+		"with_runtime.lte":              paramFloat,
+		"with_companies":                paramIDs,
+		"with_genres":                   paramIDs,
+		"with_keywords":                 paramIDs,
+		"with_origin_country":           paramCountry,
+		"with_original_language":        paramString,
+		"without_companies":             paramIDs,
+		"without_genres":                paramIDs,
+		"without_keywords":              paramIDs,
+		"watch_region":                  paramCountry,
+		"with_watch_providers":          paramIDs,
+		"with_watch_monetization_types": paramString,
+		"without_watch_providers":       paramIDs,
+	})
+)
+
+// endpointSchemas are the query params accepted by each endpoint, keyed by path.Match patterns
+// against the endpoint path without the API version, or with a /4 prefix for version 4 of the
+// API. The most specific pattern wins.
+var endpointSchemas = map[string]paramSchema{
+	"/account/*":                                {"session_id": paramString},
+	"/account/*/favorite":                       {"session_id": paramString},
+	"/account/*/favorite/movies":                accountListParams,
+	"/account/*/favorite/tv":                    accountListParams,
+	"/account/*/lists":                          {"page": paramPage, "session_id": paramString},
+	"/account/*/rated/movies":                   accountListParams,
+	"/account/*/rated/tv":                       accountListParams,
+	"/account/*/rated/tv/episodes":              accountListParams,
+	"/account/*/watchlist":                      {"session_id": paramString},
+	"/account/*/watchlist/movies":               accountListParams,
+	"/account/*/watchlist/tv":                   accountListParams,
+	"/authentication":                           noParams,
+	"/authentication/guest_session/new":         noParams,
+	"/authentication/session":                   noParams,
+	"/authentication/session/convert/4":         noParams,
+	"/authentication/session/new":               noParams,
+	"/authentication/token/new":                 noParams,
+	"/authentication/token/validate_with_login": noParams,
+	"/certification/movie/list":                 noParams,
+	"/certification/tv/list":                    noParams,
+	"/collection/*":                             languageParams,
+	"/collection/*/images":                      imageParams,
+	"/collection/*/translations":                noParams,
+	"/company/*":                                noParams,
+	"/company/*/alternative_names":              noParams,
+	"/company/*/images":                         imageParams,
+	"/configuration":                            noParams,
+	"/configuration/countries":                  languageParams,
+	"/configuration/jobs":                       noParams,
+	"/configuration/languages":                  noParams,
+	"/configuration/primary_translations":       noParams,
+	"/configuration/timezones":                  noParams,
+	"/credit/*":                                 noParams,
+	"/discover/movie": discoverParams.with(paramSchema{
+		"region":                   paramCountry,
+		"include_video":            paramBool,
+		"certification":            paramString,
+		"certification.gte":        paramString,
+		"certification.lte":        paramString,
+		"certification_country":    paramCountry,
+		"year":                     paramInt,
+		"primary_release_year":     paramInt,
+		"primary_release_date.gte": paramDate,
+		"primary_release_date.lte": paramDate,
+		"release_date.gte":         paramDate,
+		"release_date.lte":         paramDate,
+		"with_release_type":        paramIDs,
+		"with_cast":                paramIDs,
+		"with_crew":                paramIDs,
+		"with_people":              paramIDs,
+	}),
+	"/discover/tv": discoverParams.with(paramSchema{
+		"timezone":                     paramString,
+		"include_null_first_air_dates": paramBool,
+		"screened_theatrically":        paramBool,
+		"first_air_date_year":          paramInt,
+		"first_air_date.gte":           paramDate,
+		"first_air_date.lte":           paramDate,
+		"air_date.gte":                 paramDate,
+		"air_date.lte":                 paramDate,
+		"with_networks":                paramInt,
+		"with_status":                  paramIDs,
+		"with_type":                    paramIDs,
+	}),
+	"/find/*":                                 languageParams.with(paramSchema{"external_source": paramExternalSource}),
+	"/genre/movie/list":                       languageParams,
+	"/genre/tv/list":                          languageParams,
+	"/guest_session/*/rated/movies":           guestListParams,
+	"/guest_session/*/rated/tv":               guestListParams,
+	"/guest_session/*/rated/tv/episodes":      guestListParams,
+	"/keyword/*":                              noParams,
+	"/list/*":                                 pagedParams,
+	"/list/*/item_status":                     languageParams.with(paramSchema{"movie_id": paramInt}),
+	"/movie/changes":                          changesParams,
+	"/movie/latest":                           noParams,
+	"/movie/now_playing":                      pagedParams.with(paramSchema{"region": paramCountry}),
+	"/movie/popular":                          pagedParams.with(paramSchema{"region": paramCountry}),
+	"/movie/top_rated":                        pagedParams.with(paramSchema{"region": paramCountry}),
+	"/movie/upcoming":                         pagedParams.with(paramSchema{"region": paramCountry}),
+	"/movie/*":                                detailsParams,
+	"/movie/*/account_states":                 accountStateParam,
+	"/movie/*/alternative_titles":             {"country": paramCountry},
+	"/movie/*/changes":                        changesParams,
+	"/movie/*/credits":                        languageParams,
+	"/movie/*/external_ids":                   noParams,
+	"/movie/*/images":                         imageParams,
+	"/movie/*/keywords":                       noParams,
+	"/movie/*/lists":                          pagedParams,
+	"/movie/*/rating":                         accountStateParam,
+	"/movie/*/recommendations":                pagedParams,
+	"/movie/*/release_dates":                  noParams,
+	"/movie/*/reviews":                        pagedParams,
+	"/movie/*/similar":                        pagedParams,
+	"/movie/*/translations":                   noParams,
+	"/movie/*/videos":                         videoParams,
+	"/movie/*/watch/providers":                noParams,
+	"/network/*":                              noParams,
+	"/network/*/alternative_names":            noParams,
+	"/network/*/images":                       noParams,
+	"/person/changes":                         changesParams,
+	"/person/latest":                          noParams,
+	"/person/popular":                         pagedParams,
+	"/person/*":                               detailsParams,
+	"/person/*/changes":                       changesParams,
+	"/person/*/combined_credits":              languageParams,
+	"/person/*/external_ids":                  noParams,
+	"/person/*/images":                        noParams,
+	"/person/*/movie_credits":                 languageParams,
+	"/person/*/translations":                  noParams,
+	"/person/*/tv_credits":                    languageParams,
+	"/review/*":                               noParams,
+	"/search/collection":                      searchParams.with(paramSchema{"region": paramCountry}),
+	"/search/company":                         {"query": paramString, "page": paramPage},
+	"/search/keyword":                         {"query": paramString, "page": paramPage},
+	"/search/movie":                           searchParams.with(paramSchema{"region": paramCountry, "year": paramInt, "primary_release_year": paramInt}),
+	"/search/multi":                           searchParams,
+	"/search/person":                          searchParams,
+	"/search/tv":                              searchParams.with(paramSchema{"year": paramInt, "first_air_date_year": paramInt}),
+	"/trending/*/*":                           pagedParams,
+	"/tv/airing_today":                        pagedParams.with(paramSchema{"timezone": paramString}),
+	"/tv/changes":                             changesParams,
+	"/tv/latest":                              noParams,
+	"/tv/on_the_air":                          pagedParams.with(paramSchema{"timezone": paramString}),
+	"/tv/popular":                             pagedParams,
+	"/tv/top_rated":                           pagedParams,
+	"/tv/*":                                   detailsParams,
+	"/tv/*/account_states":                    accountStateParam,
+	"/tv/*/aggregate_credits":                 languageParams,
+	"/tv/*/alternative_titles":                noParams,
+	"/tv/*/changes":                           changesParams,
+	"/tv/*/content_ratings":                   noParams,
+	"/tv/*/credits":                           languageParams,
+	"/tv/*/episode_groups":                    noParams,
+	"/tv/*/external_ids":                      noParams,
+	"/tv/*/images":                            imageParams,
+	"/tv/*/keywords":                          noParams,
+	"/tv/*/rating":                            accountStateParam,
+	"/tv/*/recommendations":                   pagedParams,
+	"/tv/*/reviews":                           pagedParams,
+	"/tv/*/screened_theatrically":             noParams,
+	"/tv/*/similar":                           pagedParams,
+	"/tv/*/translations":                      noParams,
+	"/tv/*/videos":                            videoParams,
+	"/tv/*/season/*":                          detailsParams,
+	"/tv/*/season/*/account_states":           accountStateParam,
+	"/tv/*/season/*/aggregate_credits":        languageParams,
+	"/tv/*/season/*/credits":                  languageParams,
+	"/tv/*/season/*/external_ids":             noParams,
+	"/tv/*/season/*/images":                   imageParams,
+	"/tv/*/season/*/translations":             noParams,
+	"/tv/*/season/*/videos":                   videoParams,
+	"/tv/*/season/*/episode/*":                detailsParams,
+	"/tv/*/season/*/episode/*/account_states": accountStateParam,
+	"/tv/*/season/*/episode/*/credits":        languageParams,
+	"/tv/*/season/*/episode/*/external_ids":   noParams,
+	"/tv/*/season/*/episode/*/images":         imageParams,
+	"/tv/*/season/*/episode/*/rating":         accountStateParam,
+	"/tv/*/season/*/episode/*/translations":   noParams,
+	"/tv/*/season/*/episode/*/videos":         videoParams,
+	"/tv/season/*/changes":                    changesParams,
+	"/tv/episode/*/changes":                   changesParams,
+	"/tv/episode_group/*":                     noParams,
+	"/watch/providers/movie":                  languageParams.with(paramSchema{"watch_region": paramCountry}),
+	"/watch/providers/regions":                languageParams,
+	"/watch/providers/tv":                     languageParams.with(paramSchema{"watch_region": paramCountry}),
+
+	"/4/account/*/lists":                 {"page": paramPage},
+	"/4/account/*/movie/favorites":       v4AccountParams,
+	"/4/account/*/movie/rated":           v4AccountParams,
+	"/4/account/*/movie/recommendations": pagedParams,
+	"/4/account/*/movie/watchlist":       v4AccountParams,
+	"/4/account/*/tv/favorites":          v4AccountParams,
+	"/4/account/*/tv/rated":              v4AccountParams,
+	"/4/account/*/tv/recommendations":    pagedParams,
+	"/4/account/*/tv/watchlist":          v4AccountParams,
+	"/4/auth/access_token":               noParams,
+	"/4/auth/request_token":              noParams,
+	"/4/list":                            noParams,
+	"/4/list/*":                          pagedParams,
+	"/4/list/*/clear":                    noParams,
+	"/4/list/*/item_status":              {"media_id": paramInt, "media_type": paramString},
+	"/4/list/*/items":                    noParams,
+}
+
+type extraParams struct {
+	pattern string
+	keys    []string
+}
+
+// WithoutParamValidation sends query params as they are, without checking them
+// against the params each endpoint accepts.
+func WithoutParamValidation() ClientOption {
+	return func(c *Client) {
+		c.skipParamValidation = true
+	}
+}
+
+// WithExtraParams accepts keys on the endpoints matching pattern on top of the known ones,
+// e.g. for params TMDb added after this version of the client. Patterns use path.Match
+// syntax against the endpoint path without the API version, e.g. "/movie/*".
+// Values of extra params are not checked.
+func WithExtraParams(pattern string, keys ...string) ClientOption {
+	return func(c *Client) {
+		c.extraParams = append(c.extraParams, extraParams{pattern: pattern, keys: keys})
+	}
+}
+
+// schemaFor returns the schema of the most specific pattern matching endpoint,
+// the one with the fewest wildcard segments.
+func schemaFor(endpoint string) (paramSchema, bool) {
+	var (
+		best      paramSchema
+		bestScore = -1
+	)
+	for pattern, schema := range endpointSchemas {
+		if ok, _ := path.Match(pattern, endpoint); !ok {
+			continue
+		}
+		score := strings.Count(pattern, "/") - strings.Count(pattern, "*")
+		if score > bestScore {
+			best, bestScore = schema, score
+		}
+	}
+	return best, bestScore >= 0
+}
+
+func (c *Client) isExtraParam(endpoint, key string) bool {
+	for _, extra := range c.extraParams {
+		if ok, _ := path.Match(extra.pattern, endpoint); !ok {
+			continue
+		}
+		for _, k := range extra.keys {
+			if k == key {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// validateParams checks the query params of a request to endpoint against its schema.
+// Endpoints without a schema are not checked.
+func (c *Client) validateParams(endpoint string, v url.Values) error {
+	if c.skipParamValidation {
+		return nil
+	}
+	schema, ok := schemaFor(endpoint)
+	if !ok {
+		return nil
+	}
+
+	keys := make([]string, 0, len(v))
+	for key := range v {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		kind, known := schema[key]
+		if !known {
+			if c.isExtraParam(endpoint, key) {
+				continue
+			}
+			return fmt.Errorf("%w: %s does not accept %q", ErrInvalidQueryParams, endpoint, key)
+		}
+		for _, value := range v[key] {
+			if expected, ok := kind.check(value); !ok {
+				return fmt.Errorf("%w: %s must be %s, got %q", ErrInvalidQueryParams, key, expected, value)
+			}
+		}
+	}
+	return nil
+}
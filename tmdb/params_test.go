@@ -0,0 +1,104 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+// yearRange is a user defined QueryParam setting a release year window.
+type yearRange struct {
+	from, to int
+}
+
+func (y yearRange) GetKey() string {
+	return "primary_release_date"
+}
+
+func (y yearRange) Apply(v url.Values) {
+	v.Set("primary_release_date.gte", strconv.Itoa(y.from)+"-01-01")
+	v.Set("primary_release_date.lte", strconv.Itoa(y.to)+"-12-31")
+}
+
+func TestClientParamValidation(t *testing.T) {
+	newServer := func(calls *int32, opts ...ClientOption) (*Client, func()) {
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(calls, 1)
+			_, _ = w.Write([]byte(`{"page":1,"total_pages":1,"results":[]}`))
+		}, opts...)
+		return testClient, testServer.Close
+	}
+
+	t.Run("Rejects unknown and malformed params", func(t *testing.T) {
+		var calls int32
+		testClient, closeServer := newServer(&calls)
+		defer closeServer()
+
+		invalid := [][]QueryParam{
+			{SingleQueryParam{"query", "tokyo"}, SingleQueryParam{"langauge", "en-US"}},
+			{SingleQueryParam{"query", "tokyo"}, SingleQueryParam{"year", "fifties"}},
+			{SingleQueryParam{"query", "tokyo"}, SingleQueryParam{"include_adult", "yes"}},
+			{SingleQueryParam{"query", "tokyo"}, SingleQueryParam{"region", "usa"}},
+			{SingleQueryParam{"query", "tokyo"}, SingleQueryParam{"page", 501}},
+		}
+		for _, params := range invalid {
+			if _, err := testClient.Search.GetMovie(context.Background(), params...); !errors.Is(err, ErrInvalidQueryParams) {
+				t.Errorf("expected ErrInvalidQueryParams for %v, got %v", params, err)
+			}
+		}
+
+		// start_date is accepted by /movie/changes, but not by the details of a movie
+		if _, err := testClient.Changes.GetMovieChanges(context.Background(), SingleQueryParam{"start_date", "2024-01-01"}); err != nil {
+			t.Errorf("expected start_date to be accepted, got %v", err)
+		}
+		if _, err := testClient.Movies.GetDetails(context.Background(), 18148, SingleQueryParam{"start_date", "2024-01-01"}); !errors.Is(err, ErrInvalidQueryParams) {
+			t.Errorf("expected ErrInvalidQueryParams, got %v", err)
+		}
+
+		if calls != 1 {
+			t.Errorf("expected only the valid request to be sent, got %d", calls)
+		}
+	})
+
+	t.Run("Accepts user defined params", func(t *testing.T) {
+		var calls int32
+		testClient, closeServer := newServer(&calls)
+		defer closeServer()
+
+		if _, err := testClient.Discover.GetMovies(context.Background(), yearRange{1950, 1959}); err != nil {
+			t.Fatal(err)
+		}
+
+		if calls != 1 {
+			t.Errorf("expected 1 call, got %d", calls)
+		}
+	})
+
+	t.Run("Extra params and opting out", func(t *testing.T) {
+		var calls int32
+		testClient, closeServer := newServer(&calls, WithExtraParams("/search/*", "new_filter"))
+		defer closeServer()
+
+		if _, err := testClient.Search.GetMovie(context.Background(), SingleQueryParam{"new_filter", "x"}); err != nil {
+			t.Errorf("expected extra param to be accepted, got %v", err)
+		}
+		if _, err := testClient.Discover.GetMovies(context.Background(), SingleQueryParam{"new_filter", "x"}); !errors.Is(err, ErrInvalidQueryParams) {
+			t.Errorf("expected extra param to be limited to its pattern, got %v", err)
+		}
+
+		uncheckedClient, closeUnchecked := newServer(&calls, WithoutParamValidation())
+		defer closeUnchecked()
+
+		if _, err := uncheckedClient.Search.GetMovie(context.Background(), SingleQueryParam{"anything", "goes"}); err != nil {
+			t.Errorf("expected params to be sent unchecked, got %v", err)
+		}
+
+		if calls != 2 {
+			t.Errorf("expected 2 calls, got %d", calls)
+		}
+	})
+}
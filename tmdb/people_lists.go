@@ -7,45 +7,40 @@ import (
 )
 
 type PeopleListsService interface {
-	GetPopular(ctx context.Context, queryParams ...queryParam) (*PeopleListPopularResponse, error)
+	GetPopular(ctx context.Context, queryParams ...QueryParam) (*PeopleListPopularResponse, error)
 }
 
 type PeopleListsClient struct {
 	baseClient *Client
 }
 
+type PopularPerson struct {
+	Adult              bool         `json:"adult"`
+	Gender             int32        `json:"gender"`
+	ID                 int32        `json:"id"`
+	KnownFor           MediaResults `json:"known_for"`
+	KnownForDepartment string       `json:"known_for_department"`
+	Name               string       `json:"name"`
+	Popularity         float64      `json:"popularity"`
+	ProfilePath        string       `json:"profile_path"`
+}
+
 type PeopleListPopularResponse struct {
-	Page    int `json:"page"`
-	Results []struct {
-		Adult    bool  `json:"adult"`
-		Gender   int32 `json:"gender"`
-		ID       int32 `json:"id"`
-		KnownFor []struct {
-			Adult            bool    `json:"adult"`
-			BackdropPath     string  `json:"backdrop_path"`
-			GenreIds         []int32 `json:"genre_ids"`
-			ID               int32   `json:"id"`
-			MediaType        string  `json:"media_type"`
-			OriginalLanguage string  `json:"original_language"`
-			OriginalTitle    string  `json:"original_title"`
-			Overview         string  `json:"overview"`
-			PosterPath       string  `json:"poster_path"`
-			ReleaseDate      string  `json:"release_date"`
-			Title            string  `json:"title"`
-			Video            bool    `json:"video"`
-			VoteAverage      float64 `json:"vote_average"`
-			VoteCount        int32   `json:"vote_count"`
-		} `json:"known_for"`
-		KnownForDepartment string  `json:"known_for_department"`
-		Name               string  `json:"name"`
-		Popularity         float64 `json:"popularity"`
-		ProfilePath        string  `json:"profile_path"`
-	} `json:"results"`
-	TotalPages   int `json:"total_pages"`
-	TotalResults int `json:"total_results"`
+	Page         int             `json:"page"`
+	Results      []PopularPerson `json:"results"`
+	TotalPages   int             `json:"total_pages"`
+	TotalResults int             `json:"total_results"`
+}
+
+func (r *PeopleListPopularResponse) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *PeopleListPopularResponse) results() []PopularPerson {
+	return r.Results
 }
 
-func (pc *PeopleListsClient) GetPopular(ctx context.Context, queryParams ...queryParam) (*PeopleListPopularResponse, error) {
+func (pc *PeopleListsClient) GetPopular(ctx context.Context, queryParams ...QueryParam) (*PeopleListPopularResponse, error) {
 	resp, err := pc.baseClient.request(ctx, http.MethodGet, "/person/popular", queryParams...)
 	if err != nil {
 		return nil, err
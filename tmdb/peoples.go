@@ -8,14 +8,15 @@ import (
 )
 
 type PeoplesService interface {
-	GetDetails(ctx context.Context, personId int32, queryParams ...queryParam) (*PeopleResponse, error)
-	GetChanges(ctx context.Context, personId int32, queryParams ...queryParam) (*PeopleChangesResponse, error)
-	GetCombinedCredits(ctx context.Context, personId int32, queryParams ...queryParam) (*PeopleCombinedCreditsResponse, error)
+	GetDetails(ctx context.Context, personId int32, queryParams ...QueryParam) (*PeopleResponse, error)
+	GetDetailsWith(ctx context.Context, personId int32, queryParams ...QueryParam) (*PeopleResponse, error)
+	GetChanges(ctx context.Context, personId int32, queryParams ...QueryParam) (*PeopleChangesResponse, error)
+	GetCombinedCredits(ctx context.Context, personId int32, queryParams ...QueryParam) (*PeopleCombinedCreditsResponse, error)
 	GetExternalIds(ctx context.Context, personId int32) (*PeopleExternalIdsResponse, error)
 	GetImages(ctx context.Context, personId int32) (*PeopleImagesResponse, error)
 	GetLatest(ctx context.Context) (*PeopleLatestResponse, error)
-	GetMovieCredits(ctx context.Context, personId int32, queryParams ...queryParam) (*PeopleMovieCreditsResponse, error)
-	GetTVCredits(ctx context.Context, personId int32, queryParams ...queryParam) (*PeopleTVCreditsResponse, error)
+	GetMovieCredits(ctx context.Context, personId int32, queryParams ...QueryParam) (*PeopleMovieCreditsResponse, error)
+	GetTVCredits(ctx context.Context, personId int32, queryParams ...QueryParam) (*PeopleTVCreditsResponse, error)
 	GetTranslations(ctx context.Context, personId int32) (*PeopleTranslationsResponse, error)
 }
 
@@ -38,20 +39,28 @@ type PeopleResponse struct {
 	PlaceOfBirth       string   `json:"place_of_birth"`
 	Popularity         float32  `json:"popularity"`
 	ProfilePath        string   `json:"profile_path"`
+
+	// Appended sub-resources, only set when requested through GetDetailsWith.
+	CombinedCredits *PeopleCombinedCreditsResponse `json:"combined_credits,omitempty"`
+	ExternalIDs     *PeopleExternalIdsResponse     `json:"external_ids,omitempty"`
+	Images          *PeopleImagesResponse          `json:"images,omitempty"`
+	MovieCredits    *PeopleMovieCreditsResponse    `json:"movie_credits,omitempty"`
+	TVCredits       *PeopleTVCreditsResponse       `json:"tv_credits,omitempty"`
+	Translations    *PeopleTranslationsResponse    `json:"translations,omitempty"`
+}
+
+// peopleDetailsAppends are the sub-resources PeopleResponse can hold.
+var peopleDetailsAppends = []AppendToResponse{
+	AppendCombinedCredits,
+	AppendExternalIDs,
+	AppendImages,
+	AppendMovieCredits,
+	AppendTVCredits,
+	AppendTranslations,
 }
 
 type PeopleChangesResponse struct {
-	Changes []struct {
-		Key   string `json:"key"`
-		Items []struct {
-			ID         string `json:"id"`
-			Action     string `json:"action"`
-			Time       string `json:"time"`
-			Iso_639_1  string `json:"iso_639_1"`
-			Iso_3166_1 string `json:"iso_3166_1"`
-			Value      string `json:"value"`
-		} `json:"items"`
-	} `json:"changes"`
+	Changes []Change `json:"changes"`
 }
 
 type Cast struct {
@@ -107,7 +116,7 @@ type PeopleExternalIdsResponse struct {
 	FreebaseID  string `json:"freebase_id"`
 	FreebaseMID string `json:"freebase_mid"`
 	IMDBID      string `json:"imdb_id"`
-	TvrageID    string `json:"tvrage_id"`
+	TvrageID    int32  `json:"tvrage_id"`
 	WikidataId  string `json:"wikidata_id"`
 	FacebookID  string `json:"facebook_id"`
 	InstagramID string `json:"instagram_id"`
@@ -116,8 +125,18 @@ type PeopleExternalIdsResponse struct {
 	YoutubeID   string `json:"youtube_id"`
 }
 
-// TODO: figure this out
-type PeopleImagesResponse struct{}
+type PeopleImagesResponse struct {
+	ID       int32 `json:"id"`
+	Profiles []struct {
+		AspectRatio float32 `json:"aspect_ratio"`
+		FilePath    string  `json:"file_path"`
+		Height      int32   `json:"height"`
+		Iso_639_1   string  `json:"iso_639_1"`
+		VoteAverage float32 `json:"vote_average"`
+		VoteCount   int32   `json:"vote_count"`
+		Width       int32   `json:"width"`
+	} `json:"profiles"`
+}
 
 type PeopleLatestResponse struct {
 	Adult              bool     `json:"adult"`
@@ -137,9 +156,9 @@ type PeopleLatestResponse struct {
 }
 
 type PeopleMovieCreditsResponse struct {
-	ID   int32 `json:"id"`
-	Cast Cast  `json:"cast"`
-	Crew Crew  `json:"crew"`
+	ID   int32  `json:"id"`
+	Cast []Cast `json:"cast"`
+	Crew []Crew `json:"crew"`
 }
 
 type PeopleTVCreditsResponse struct {
@@ -167,7 +186,7 @@ type PeopleTranslationsResponse struct {
 	} `json:"translations"`
 }
 
-func (c *PeopleClient) GetDetails(ctx context.Context, personId int32, queryParams ...queryParam) (*PeopleResponse, error) {
+func (c *PeopleClient) GetDetails(ctx context.Context, personId int32, queryParams ...QueryParam) (*PeopleResponse, error) {
 	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/person/%d", personId), queryParams...)
 	if err != nil {
 		return nil, err
@@ -181,7 +200,16 @@ func (c *PeopleClient) GetDetails(ctx context.Context, personId int32, queryPara
 	return &people, nil
 }
 
-func (c *PeopleClient) GetChanges(ctx context.Context, personId int32, queryParams ...queryParam) (*PeopleChangesResponse, error) {
+// GetDetailsWith fetches the details of a person together with the given AppendToResponse
+// sub-resources in a single request. Other query params, e.g. language, can be mixed in.
+func (c *PeopleClient) GetDetailsWith(ctx context.Context, personId int32, queryParams ...QueryParam) (*PeopleResponse, error) {
+	if err := checkAppends("person details", peopleDetailsAppends, queryParams); err != nil {
+		return nil, err
+	}
+	return c.GetDetails(ctx, personId, queryParams...)
+}
+
+func (c *PeopleClient) GetChanges(ctx context.Context, personId int32, queryParams ...QueryParam) (*PeopleChangesResponse, error) {
 	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/person/%d/changes", personId), queryParams...)
 	if err != nil {
 		return nil, err
@@ -195,7 +223,7 @@ func (c *PeopleClient) GetChanges(ctx context.Context, personId int32, queryPara
 	return &people, nil
 }
 
-func (c *PeopleClient) GetCombinedCredits(ctx context.Context, personId int32, queryParams ...queryParam) (*PeopleCombinedCreditsResponse, error) {
+func (c *PeopleClient) GetCombinedCredits(ctx context.Context, personId int32, queryParams ...QueryParam) (*PeopleCombinedCreditsResponse, error) {
 	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/person/%d/combined_credits", personId), queryParams...)
 	if err != nil {
 		return nil, err
@@ -251,7 +279,7 @@ func (c *PeopleClient) GetLatest(ctx context.Context) (*PeopleLatestResponse, er
 	return &people, nil
 }
 
-func (c *PeopleClient) GetMovieCredits(ctx context.Context, personId int32, queryParams ...queryParam) (*PeopleMovieCreditsResponse, error) {
+func (c *PeopleClient) GetMovieCredits(ctx context.Context, personId int32, queryParams ...QueryParam) (*PeopleMovieCreditsResponse, error) {
 	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/person/%d/movie_credits", personId), queryParams...)
 	if err != nil {
 		return nil, err
@@ -265,7 +293,7 @@ func (c *PeopleClient) GetMovieCredits(ctx context.Context, personId int32, quer
 	return &people, nil
 }
 
-func (c *PeopleClient) GetTVCredits(ctx context.Context, personId int32, queryParams ...queryParam) (*PeopleTVCreditsResponse, error) {
+func (c *PeopleClient) GetTVCredits(ctx context.Context, personId int32, queryParams ...QueryParam) (*PeopleTVCreditsResponse, error) {
 	resp, err := c.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/person/%d/tv_credits", personId), queryParams...)
 	if err != nil {
 		return nil, err
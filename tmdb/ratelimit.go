@@ -0,0 +1,117 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket shared by every service of a Client.
+// Tokens refill continuously at rate per second up to burst.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token, possibly borrowing against the future, and returns how long
+// the caller has to wait before the token may be used.
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens += elapsed.Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+		l.last = now
+	}
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel hands back a token taken by reserve that was never used.
+func (l *rateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens++
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
+
+// wait blocks until a token is available and returns how long it waited.
+// It fails fast with a *RateLimitWaitError when ctx would expire before the token is available.
+func (l *rateLimiter) wait(ctx context.Context) (time.Duration, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	delay := l.reserve(now)
+	if delay == 0 {
+		return 0, nil
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && deadline.Before(now.Add(delay)) {
+		l.cancel()
+		return 0, &RateLimitWaitError{Wait: delay, Err: context.DeadlineExceeded}
+	}
+
+	if err := sleepContext(ctx, delay); err != nil {
+		l.cancel()
+		return time.Since(now), &RateLimitWaitError{Wait: delay, Err: err}
+	}
+
+	return delay, nil
+}
+
+// RateLimitWaitError is returned when the client side rate limiter cannot hand out
+// a token before the request context is done.
+type RateLimitWaitError struct {
+	// Wait is how long the request would have had to wait for a token.
+	Wait time.Duration
+	// Err is the context error that stopped the wait.
+	Err error
+}
+
+func (e *RateLimitWaitError) Error() string {
+	return fmt.Sprintf("%s: need to wait %s: %v", ErrClientRateLimited, e.Wait, e.Err)
+}
+
+func (e *RateLimitWaitError) Unwrap() []error {
+	return []error{ErrClientRateLimited, e.Err}
+}
+
+// WithRateLimit throttles every request made through the client, across all services,
+// to requestsPerSecond with bursts of up to burst requests.
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOption {
+	return func(c *Client) {
+		if requestsPerSecond <= 0 {
+			c.limiter = nil
+			return
+		}
+		c.limiter = newRateLimiter(requestsPerSecond, burst)
+	}
+}
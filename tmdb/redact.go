@@ -0,0 +1,105 @@
+package tmdb
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// redaction of credentials from anything the client hands back or writes out
+
+const redacted = "REDACTED"
+
+// sensitiveParams are query parameters that carry credentials.
+var sensitiveParams = []string{"api_key", "session_id", "guest_session_id", "request_token", "access_token"}
+
+// redactURL replaces the value of every credential query parameter and the guest session ID
+// in the path of rawURL.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return redactPath(redactQueryString(rawURL))
+	}
+
+	path := redactPath(u.Path)
+	if u.RawQuery == "" && path == u.Path {
+		return rawURL
+	}
+	u.Path, u.RawPath = path, redactPath(u.RawPath)
+	u.RawQuery = redactQueryString(u.RawQuery)
+	return u.String()
+}
+
+// redactPath replaces the guest session ID in paths like /3/guest_session/{id}/rated/movies.
+func redactPath(p string) string {
+	segments := strings.Split(p, "/")
+	for i := 1; i < len(segments)-1; i++ {
+		// /authentication/guest_session/new creates a session, it carries no ID
+		if segments[i] == "guest_session" && segments[i-1] != "authentication" {
+			segments[i+1] = redacted
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// redactQueryString scrubs credentials from a raw query string (or any string containing one)
+// without re-encoding the rest of it.
+func redactQueryString(s string) string {
+	for _, key := range sensitiveParams {
+		needle := key + "="
+		for i := 0; i < len(s); {
+			j := strings.Index(s[i:], needle)
+			if j < 0 {
+				break
+			}
+			start := i + j
+			// only match whole keys, e.g. not "guest_session_id" when looking for "session_id"
+			if start > 0 && s[start-1] != '?' && s[start-1] != '&' && s[start-1] != ';' {
+				i = start + len(needle)
+				continue
+			}
+			valueStart := start + len(needle)
+			valueEnd := strings.IndexAny(s[valueStart:], "&;# \"'")
+			if valueEnd < 0 {
+				valueEnd = len(s)
+			} else {
+				valueEnd += valueStart
+			}
+			s = s[:valueStart] + redacted + s[valueEnd:]
+			i = valueStart + len(redacted)
+		}
+	}
+	return s
+}
+
+// redactError strips credentials from errors returned by the client,
+// most notably the *url.Error values produced by http.Client.Do which embed the full request URL.
+func (c *Client) redactError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) && urlErr == err {
+		err = &url.Error{Op: urlErr.Op, URL: redactURL(urlErr.URL), Err: c.redactError(urlErr.Err)}
+	}
+
+	if c.token != "" && strings.Contains(err.Error(), c.token) {
+		return &redactedError{err: err, token: c.token}
+	}
+	return err
+}
+
+// redactedError hides a secret from the message of an error while keeping it unwrappable.
+type redactedError struct {
+	err   error
+	token string
+}
+
+func (e *redactedError) Error() string {
+	return strings.ReplaceAll(e.err.Error(), e.token, redacted)
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
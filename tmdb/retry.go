@@ -0,0 +1,108 @@
+package tmdb
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+)
+
+// retry helpers used by Client.request
+
+func defaultRetryMethods() map[string]bool {
+	return map[string]bool{
+		http.MethodGet:     true,
+		http.MethodHead:    true,
+		http.MethodOptions: true,
+		http.MethodPut:     true,
+		http.MethodDelete:  true,
+	}
+}
+
+// retryableEndpoint reports whether GETs to endpoint may be repeated. Clearing a v4 list is a
+// GET, and a 5xx may arrive after TMDb already cleared it.
+func retryableEndpoint(endpoint string) bool {
+	clearsList, _ := path.Match("/4/list/*/clear", endpoint)
+	return !clearsList
+}
+
+// shouldRetry reports whether a failed attempt is worth repeating.
+// resp is nil when the transport itself failed.
+func (c *Client) shouldRetry(ctx context.Context, method string, resp *http.Response, err error) bool {
+	if ctx.Err() != nil || !c.retryMethods[method] {
+		return false
+	}
+
+	if resp == nil {
+		return err != nil
+	}
+
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+// retryWait returns how long to wait before the next attempt.
+// A valid Retry-After header wins, otherwise a jittered exponential backoff is used.
+// ok is false when Retry-After asks for more than retryWaitMax, so the call is not retried.
+func (c *Client) retryWait(attempt int, resp *http.Response) (wait time.Duration, ok bool) {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return wait, c.retryWaitMax <= 0 || wait <= c.retryWaitMax
+		}
+	}
+
+	if c.retryWaitMin <= 0 || c.retryWaitMax <= 0 {
+		return 0, true
+	}
+
+	backoff := c.retryWaitMin << uint(attempt)
+	if backoff <= 0 || backoff > c.retryWaitMax {
+		backoff = c.retryWaitMax
+	}
+
+	// keep at least half of the backoff so retries never bunch up at zero
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1)), true
+}
+
+// parseRetryAfter parses both forms of the Retry-After header: delay seconds and an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// sleepContext waits for d or until ctx is done, whichever happens first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
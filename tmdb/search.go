@@ -7,157 +7,194 @@ import (
 )
 
 type SearchService interface {
-	GetCollection(ctx context.Context, queryParams ...queryParam) (*SearchCollectionResponse, error)
-	GetCompany(ctx context.Context, queryParams ...queryParam) (*SearchCompanyResponse, error)
-	GetKeyword(ctx context.Context, queryParams ...queryParam) (*SearchKeywordResponse, error)
-	GetMovie(ctx context.Context, queryParams ...queryParam) (*SearchMovieResponse, error)
-	GetMulti(ctx context.Context, queryParams ...queryParam) (*SearchMultiResponse, error)
-	GetPerson(ctx context.Context, queryParams ...queryParam) (*SearchPersonResponse, error)
-	GetTv(ctx context.Context, queryParams ...queryParam) (*SearchTvResponse, error)
+	GetCollection(ctx context.Context, queryParams ...QueryParam) (*SearchCollectionResponse, error)
+	GetCompany(ctx context.Context, queryParams ...QueryParam) (*SearchCompanyResponse, error)
+	GetKeyword(ctx context.Context, queryParams ...QueryParam) (*SearchKeywordResponse, error)
+	GetMovie(ctx context.Context, queryParams ...QueryParam) (*SearchMovieResponse, error)
+	GetMulti(ctx context.Context, queryParams ...QueryParam) (*SearchMultiResponse, error)
+	GetPerson(ctx context.Context, queryParams ...QueryParam) (*SearchPersonResponse, error)
+	GetTv(ctx context.Context, queryParams ...QueryParam) (*SearchTvResponse, error)
 }
 
 type SearchClient struct {
 	baseClient *Client
 }
 
+type SearchCollectionResult struct {
+	Adult            bool   `json:"adult"`
+	BackdropPath     string `json:"backdrop_path"`
+	ID               int    `json:"id"`
+	Name             string `json:"name"`
+	OriginalLanguage string `json:"original_language"`
+	OriginalName     string `json:"original_name"`
+	Overview         string `json:"overview"`
+	PosterPath       string `json:"poster_path"`
+}
+
 type SearchCollectionResponse struct {
-	Page    int `json:"page"`
-	Results []struct {
-		Adult            bool   `json:"adult"`
-		BackdropPath     string `json:"backdrop_path"`
-		ID               int    `json:"id"`
-		Name             string `json:"name"`
-		OriginalLanguage string `json:"original_language"`
-		OriginalName     string `json:"original_name"`
-		Overview         string `json:"overview"`
-		PosterPath       string `json:"poster_path"`
-	} `json:"results"`
-	TotalPages   int `json:"total_pages"`
-	TotalResults int `json:"total_results"`
+	Page         int                      `json:"page"`
+	Results      []SearchCollectionResult `json:"results"`
+	TotalPages   int                      `json:"total_pages"`
+	TotalResults int                      `json:"total_results"`
+}
+
+func (r *SearchCollectionResponse) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *SearchCollectionResponse) results() []SearchCollectionResult {
+	return r.Results
+}
+
+type SearchCompanyResult struct {
+	ID            int    `json:"id"`
+	LogoPath      string `json:"logo_path"`
+	Name          string `json:"name"`
+	OriginCountry string `json:"origin_country"`
 }
 
 type SearchCompanyResponse struct {
-	Page    int `json:"page"`
-	Results []struct {
-		ID            int    `json:"id"`
-		LogoPath      string `json:"logo_path"`
-		Name          string `json:"name"`
-		OriginCountry string `json:"origin_country"`
-	} `json:"results"`
-	TotalPages   int `json:"total_pages"`
-	TotalResults int `json:"total_results"`
+	Page         int                   `json:"page"`
+	Results      []SearchCompanyResult `json:"results"`
+	TotalPages   int                   `json:"total_pages"`
+	TotalResults int                   `json:"total_results"`
+}
+
+func (r *SearchCompanyResponse) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *SearchCompanyResponse) results() []SearchCompanyResult {
+	return r.Results
+}
+
+type SearchKeywordResult struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
 }
 
 type SearchKeywordResponse struct {
-	Page    int `json:"page"`
-	Results []struct {
-		ID   int    `json:"id"`
-		Name string `json:"name"`
-	} `json:"results"`
-	TotalPages   int `json:"total_pages"`
-	TotalResults int `json:"total_results"`
+	Page         int                   `json:"page"`
+	Results      []SearchKeywordResult `json:"results"`
+	TotalPages   int                   `json:"total_pages"`
+	TotalResults int                   `json:"total_results"`
+}
+
+func (r *SearchKeywordResponse) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *SearchKeywordResponse) results() []SearchKeywordResult {
+	return r.Results
+}
+
+type SearchMovieResult struct {
+	Adult            bool    `json:"adult"`
+	BackdropPath     string  `json:"backdrop_path"`
+	GenreIds         []int32 `json:"genre_ids"`
+	ID               int32   `json:"id"`
+	OriginalLanguage string  `json:"original_language"`
+	OriginalTitle    string  `json:"original_title"`
+	Overview         string  `json:"overview"`
+	Popularity       float64 `json:"popularity"`
+	PosterPath       string  `json:"poster_path"`
+	ReleaseDate      string  `json:"release_date"`
+	Title            string  `json:"title"`
+	Video            bool    `json:"video"`
+	VoteAverage      float64 `json:"vote_average"`
+	VoteCount        int32   `json:"vote_count"`
 }
 
 type SearchMovieResponse struct {
-	Page    int `json:"page"`
-	Results []struct {
-		Adult            bool    `json:"adult"`
-		BackdropPath     string  `json:"backdrop_path"`
-		GenreIds         []int32 `json:"genre_ids"`
-		ID               int32   `json:"id"`
-		OriginalLanguage string  `json:"original_language"`
-		OriginalTitle    string  `json:"original_title"`
-		Overview         string  `json:"overview"`
-		Popularity       float64 `json:"popularity"`
-		PosterPath       string  `json:"poster_path"`
-		ReleaseDate      string  `json:"release_date"`
-		Title            string  `json:"title"`
-		Video            bool    `json:"video"`
-		VoteAverage      float64 `json:"vote_average"`
-		VoteCount        int32   `json:"vote_count"`
-	} `json:"results"`
-	TotalPages   int `json:"total_pages"`
-	TotalResults int `json:"total_results"`
+	Page         int                 `json:"page"`
+	Results      []SearchMovieResult `json:"results"`
+	TotalPages   int                 `json:"total_pages"`
+	TotalResults int                 `json:"total_results"`
+}
+
+func (r *SearchMovieResponse) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *SearchMovieResponse) results() []SearchMovieResult {
+	return r.Results
 }
 
 type SearchMultiResponse struct {
-	Page    int `json:"page"`
-	Results []struct {
-		Adult            bool    `json:"adult"`
-		BackdropPath     string  `json:"backdrop_path"`
-		GenreIds         []int32 `json:"genre_ids"`
-		ID               int32   `json:"id"`
-		OriginalLanguage string  `json:"original_language"`
-		OriginalTitle    string  `json:"original_title"`
-		Overview         string  `json:"overview"`
-		Popularity       float64 `json:"popularity"`
-		PosterPath       string  `json:"poster_path"`
-		ReleaseDate      string  `json:"release_date"`
-		Title            string  `json:"title"`
-		Video            bool    `json:"video"`
-		VoteAverage      float64 `json:"vote_average"`
-		VoteCount        int32   `json:"vote_count"`
-		MediaType        string  `json:"media_type"`
-	} `json:"results"`
-	TotalPages   int `json:"total_pages"`
-	TotalResults int `json:"total_results"`
+	Page         int          `json:"page"`
+	Results      MediaResults `json:"results"`
+	TotalPages   int          `json:"total_pages"`
+	TotalResults int          `json:"total_results"`
+}
+
+func (r *SearchMultiResponse) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *SearchMultiResponse) results() []MediaResult {
+	return r.Results
+}
+
+type SearchPersonResult struct {
+	Adult              bool         `json:"adult"`
+	Gender             int32        `json:"gender"`
+	ID                 int32        `json:"id"`
+	KnownForDepartment string       `json:"known_for_department"`
+	Name               string       `json:"name"`
+	OriginalName       string       `json:"original_name"`
+	Popularity         float64      `json:"popularity"`
+	ProfilePath        string       `json:"profile_path"`
+	KnownFor           MediaResults `json:"known_for"`
 }
 
 type SearchPersonResponse struct {
-	Page    int `json:"page"`
-	Results []struct {
-		Adult              bool    `json:"adult"`
-		Gender             int32   `json:"gender"`
-		ID                 int32   `json:"id"`
-		KnownForDepartment string  `json:"known_for_department"`
-		Name               string  `json:"name"`
-		OriginalName       string  `json:"original_name"`
-		Popularity         float64 `json:"popularity"`
-		ProfilePath        string  `json:"profile_path"`
-		KnownFor           []struct {
-			Adult            bool    `json:"adult"`
-			BackdropPath     string  `json:"backdrop_path"`
-			GenreIds         []int32 `json:"genre_ids"`
-			ID               int32   `json:"id"`
-			MediaType        string  `json:"media_type"`
-			OriginalLanguage string  `json:"original_language"`
-			OriginalTitle    string  `json:"original_title"`
-			Overview         string  `json:"overview"`
-			PosterPath       string  `json:"poster_path"`
-			ReleaseDate      string  `json:"release_date"`
-			Title            string  `json:"title"`
-			Video            bool    `json:"video"`
-			VoteAverage      float64 `json:"vote_average"`
-			VoteCount        int32   `json:"vote_count"`
-		} `json:"known_for"`
-	} `json:"results"`
-	TotalPages   int `json:"total_pages"`
-	TotalResults int `json:"total_results"`
+	Page         int                  `json:"page"`
+	Results      []SearchPersonResult `json:"results"`
+	TotalPages   int                  `json:"total_pages"`
+	TotalResults int                  `json:"total_results"`
+}
+
+func (r *SearchPersonResponse) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *SearchPersonResponse) results() []SearchPersonResult {
+	return r.Results
+}
+
+type SearchTvResult struct {
+	Adult            bool     `json:"adult"`
+	BackdropPath     string   `json:"backdrop_path"`
+	FirstAirDate     string   `json:"first_air_date"`
+	GenreIds         []int32  `json:"genre_ids"`
+	ID               int32    `json:"id"`
+	Name             string   `json:"name"`
+	OriginCountry    []string `json:"origin_country"`
+	OriginalLanguage string   `json:"original_language"`
+	OriginalName     string   `json:"original_name"`
+	Overview         string   `json:"overview"`
+	Popularity       float64  `json:"popularity"`
+	PosterPath       string   `json:"poster_path"`
+	VoteAverage      float64  `json:"vote_average"`
+	VoteCount        int32    `json:"vote_count"`
 }
 
 type SearchTvResponse struct {
-	Page    int `json:"page"`
-	Results []struct {
-		Adult            bool     `json:"adult"`
-		BackdropPath     string   `json:"backdrop_path"`
-		FirstAirDate     string   `json:"first_air_date"`
-		GenreIds         []int32  `json:"genre_ids"`
-		ID               int32    `json:"id"`
-		Name             string   `json:"name"`
-		OriginCountry    []string `json:"origin_country"`
-		OriginalLanguage string   `json:"original_language"`
-		OriginalName     string   `json:"original_name"`
-		Overview         string   `json:"overview"`
-		Popularity       float64  `json:"popularity"`
-		PosterPath       string   `json:"poster_path"`
-		VoteAverage      float64  `json:"vote_average"`
-		VoteCount        int32    `json:"vote_count"`
-	} `json:"results"`
-	TotalPages   int `json:"total_pages"`
-	TotalResults int `json:"total_results"`
-}
-
-func (sc *SearchClient) GetCollection(ctx context.Context, queryParams ...queryParam) (*SearchCollectionResponse, error) {
+	Page         int              `json:"page"`
+	Results      []SearchTvResult `json:"results"`
+	TotalPages   int              `json:"total_pages"`
+	TotalResults int              `json:"total_results"`
+}
+
+func (r *SearchTvResponse) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *SearchTvResponse) results() []SearchTvResult {
+	return r.Results
+}
+
+func (sc *SearchClient) GetCollection(ctx context.Context, queryParams ...QueryParam) (*SearchCollectionResponse, error) {
 	resp, err := sc.baseClient.request(ctx, http.MethodGet, "/search/collection", queryParams...)
 	if err != nil {
 		return nil, err
@@ -171,7 +208,7 @@ func (sc *SearchClient) GetCollection(ctx context.Context, queryParams ...queryP
 	return &result, nil
 }
 
-func (sc *SearchClient) GetCompany(ctx context.Context, queryParams ...queryParam) (*SearchCompanyResponse, error) {
+func (sc *SearchClient) GetCompany(ctx context.Context, queryParams ...QueryParam) (*SearchCompanyResponse, error) {
 	resp, err := sc.baseClient.request(ctx, http.MethodGet, "/search/company", queryParams...)
 	if err != nil {
 		return nil, err
@@ -185,7 +222,7 @@ func (sc *SearchClient) GetCompany(ctx context.Context, queryParams ...queryPara
 	return &result, nil
 }
 
-func (sc *SearchClient) GetKeyword(ctx context.Context, queryParams ...queryParam) (*SearchKeywordResponse, error) {
+func (sc *SearchClient) GetKeyword(ctx context.Context, queryParams ...QueryParam) (*SearchKeywordResponse, error) {
 	resp, err := sc.baseClient.request(ctx, http.MethodGet, "/search/keyword", queryParams...)
 	if err != nil {
 		return nil, err
@@ -199,7 +236,7 @@ func (sc *SearchClient) GetKeyword(ctx context.Context, queryParams ...queryPara
 	return &result, nil
 }
 
-func (sc *SearchClient) GetMovie(ctx context.Context, queryParams ...queryParam) (*SearchMovieResponse, error) {
+func (sc *SearchClient) GetMovie(ctx context.Context, queryParams ...QueryParam) (*SearchMovieResponse, error) {
 	resp, err := sc.baseClient.request(ctx, http.MethodGet, "/search/movie", queryParams...)
 	if err != nil {
 		return nil, err
@@ -213,7 +250,7 @@ func (sc *SearchClient) GetMovie(ctx context.Context, queryParams ...queryParam)
 	return &result, nil
 }
 
-func (sc *SearchClient) GetMulti(ctx context.Context, queryParams ...queryParam) (*SearchMultiResponse, error) {
+func (sc *SearchClient) GetMulti(ctx context.Context, queryParams ...QueryParam) (*SearchMultiResponse, error) {
 	resp, err := sc.baseClient.request(ctx, http.MethodGet, "/search/multi", queryParams...)
 	if err != nil {
 		return nil, err
@@ -227,7 +264,7 @@ func (sc *SearchClient) GetMulti(ctx context.Context, queryParams ...queryParam)
 	return &result, nil
 }
 
-func (sc *SearchClient) GetPerson(ctx context.Context, queryParams ...queryParam) (*SearchPersonResponse, error) {
+func (sc *SearchClient) GetPerson(ctx context.Context, queryParams ...QueryParam) (*SearchPersonResponse, error) {
 	resp, err := sc.baseClient.request(ctx, http.MethodGet, "/search/person", queryParams...)
 	if err != nil {
 		return nil, err
@@ -241,7 +278,7 @@ func (sc *SearchClient) GetPerson(ctx context.Context, queryParams ...queryParam
 	return &result, nil
 }
 
-func (sc *SearchClient) GetTv(ctx context.Context, queryParams ...queryParam) (*SearchTvResponse, error) {
+func (sc *SearchClient) GetTv(ctx context.Context, queryParams ...QueryParam) (*SearchTvResponse, error) {
 	resp, err := sc.baseClient.request(ctx, http.MethodGet, "/search/tv", queryParams...)
 	if err != nil {
 		return nil, err
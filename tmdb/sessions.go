@@ -0,0 +1,60 @@
+package tmdb
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+)
+
+// SessionID sends the session_id of a logged in user, needed for writes and account data.
+type SessionID string
+
+func (s SessionID) GetKey() string {
+	return "session_id"
+}
+
+func (s SessionID) Apply(v url.Values) {
+	v.Set(s.GetKey(), string(s))
+}
+
+// GuestSessionID sends the guest_session_id of a guest session, which can rate movies, tv series and episodes.
+type GuestSessionID string
+
+func (s GuestSessionID) GetKey() string {
+	return "guest_session_id"
+}
+
+func (s GuestSessionID) Apply(v url.Values) {
+	v.Set(s.GetKey(), string(s))
+}
+
+// requireSession fails with ErrSessionMissing unless queryParams or the default params
+// of the client set one of keys, e.g. session_id.
+func (c *Client) requireSession(queryParams []QueryParam, keys ...string) error {
+	v := url.Values{}
+	for _, param := range queryParams {
+		param.Apply(v)
+	}
+	for _, key := range keys {
+		if v.Get(key) != "" || c.defaultParams.Get(key) != "" {
+			return nil
+		}
+	}
+	if len(keys) == 1 {
+		return fmt.Errorf("%w: %s is required", ErrSessionMissing, keys[0])
+	}
+	return fmt.Errorf("%w: %s or %s is required", ErrSessionMissing, keys[0], keys[1])
+}
+
+// ratingBody is the body of the rating endpoints.
+type ratingBody struct {
+	Value float64 `json:"value"`
+}
+
+// validateRating checks rating is between 0.5 and 10 in steps of 0.5, as TMDb requires.
+func validateRating(rating float64) error {
+	if rating < 0.5 || rating > 10 || math.Mod(rating*2, 1) != 0 {
+		return fmt.Errorf("%w: rating must be between 0.5 and 10 in steps of 0.5, got %v", ErrInvalidRating, rating)
+	}
+	return nil
+}
@@ -0,0 +1,344 @@
+package tmdb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// SyncKind is a change feed followed by a Syncer.
+type SyncKind string
+
+const (
+	SyncMovies SyncKind = "movie"
+	SyncTv     SyncKind = "tv"
+	SyncPeople SyncKind = "person"
+)
+
+// Checkpoint is the progress of a change feed: every change up to Until was handed to the sink.
+type Checkpoint struct {
+	Until time.Time `json:"until"`
+}
+
+// CheckpointStore persists the checkpoint of each change feed between runs of a Syncer.
+type CheckpointStore interface {
+	// Load returns the checkpoint of kind, ok is false when none was saved yet.
+	Load(ctx context.Context, kind SyncKind) (checkpoint Checkpoint, ok bool, err error)
+	Save(ctx context.Context, kind SyncKind, checkpoint Checkpoint) error
+}
+
+// MemoryCheckpointStore keeps checkpoints in memory, so progress is lost on restart.
+type MemoryCheckpointStore struct {
+	mu          sync.Mutex
+	checkpoints map[SyncKind]Checkpoint
+}
+
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{checkpoints: map[SyncKind]Checkpoint{}}
+}
+
+func (m *MemoryCheckpointStore) Load(ctx context.Context, kind SyncKind) (Checkpoint, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	checkpoint, ok := m.checkpoints[kind]
+	return checkpoint, ok, nil
+}
+
+func (m *MemoryCheckpointStore) Save(ctx context.Context, kind SyncKind, checkpoint Checkpoint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.checkpoints[kind] = checkpoint
+	return nil
+}
+
+// FileCheckpointStore keeps the checkpoints of all change feeds in one JSON file.
+type FileCheckpointStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileCheckpointStore returns a store saving its checkpoints to path. The file is created on the first save.
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{path: path}
+}
+
+func (f *FileCheckpointStore) Load(ctx context.Context, kind SyncKind) (Checkpoint, bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	checkpoints, err := f.read()
+	if err != nil {
+		return Checkpoint{}, false, err
+	}
+	checkpoint, ok := checkpoints[kind]
+	return checkpoint, ok, nil
+}
+
+func (f *FileCheckpointStore) Save(ctx context.Context, kind SyncKind, checkpoint Checkpoint) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	checkpoints, err := f.read()
+	if err != nil {
+		return err
+	}
+	checkpoints[kind] = checkpoint
+
+	data, err := json.Marshal(checkpoints)
+	if err != nil {
+		return err
+	}
+
+	// write to a temp file first so a crash never leaves a partial checkpoint behind
+	tmp, err := os.CreateTemp(filepath.Dir(f.path), ".checkpoint-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.path)
+}
+
+func (f *FileCheckpointStore) read() (map[SyncKind]Checkpoint, error) {
+	checkpoints := map[SyncKind]Checkpoint{}
+	data, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return checkpoints, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &checkpoints); err != nil {
+		return nil, fmt.Errorf("reading checkpoints from %s: %w", f.path, err)
+	}
+	return checkpoints, nil
+}
+
+// SyncItem is a changed entity handed to the sink. Only the details matching Kind are set,
+// and none when the entity was deleted from TMDb.
+type SyncItem struct {
+	Kind      SyncKind
+	ID        int32
+	Adult     bool
+	ChangedAt time.Time
+	Deleted   bool
+
+	Movie    *MovieDetailsResponse
+	TvSeries *TvSeriesDetailsResponse
+	Person   *PeopleResponse
+}
+
+// SyncSink receives the changed entities of a Syncer. Put is called from several goroutines at once.
+type SyncSink interface {
+	Put(ctx context.Context, item SyncItem) error
+}
+
+// SyncSinkFunc adapts an ordinary function to a SyncSink.
+type SyncSinkFunc func(ctx context.Context, item SyncItem) error
+
+func (f SyncSinkFunc) Put(ctx context.Context, item SyncItem) error {
+	return f(ctx, item)
+}
+
+// SyncOptions configures a Syncer. The zero value follows every change feed.
+type SyncOptions struct {
+	// Kinds are the change feeds to follow, defaults to movies, tv series and people.
+	Kinds []SyncKind
+	// Concurrency is the number of details fetched at once, defaults to 4.
+	Concurrency int
+	// Since is where a change feed without a checkpoint starts, defaults to 24 hours ago.
+	Since time.Time
+	// DetailsParams are sent along with every GetDetails call, e.g. a language or sub-resources to append.
+	DetailsParams []QueryParam
+}
+
+// Syncer mirrors TMDb into a sink. Each run walks the change feeds from their checkpoints to now,
+// fetches the details of every changed entity once and hands them to the sink:
+//
+//	syncer := tmdb.NewSyncer(client, tmdb.NewFileCheckpointStore("checkpoints.json"),
+//		tmdb.SyncSinkFunc(func(ctx context.Context, item tmdb.SyncItem) error {
+//			// store item here
+//			return nil
+//		}), tmdb.SyncOptions{Kinds: []tmdb.SyncKind{tmdb.SyncMovies}})
+//	if err := syncer.Run(ctx); err != nil {
+//		// handle error here, the next run resumes from the last checkpoint
+//	}
+//
+// Checkpoints are saved after each window of up to 14 days, so entities changed in the window
+// a run failed in are handed to the sink again on the next run.
+type Syncer struct {
+	client *Client
+	store  CheckpointStore
+	sink   SyncSink
+	opts   SyncOptions
+	now    func() time.Time
+}
+
+func NewSyncer(client *Client, store CheckpointStore, sink SyncSink, opts SyncOptions) *Syncer {
+	if len(opts.Kinds) == 0 {
+		opts.Kinds = []SyncKind{SyncMovies, SyncTv, SyncPeople}
+	}
+	if opts.Concurrency < 1 {
+		opts.Concurrency = 4
+	}
+	return &Syncer{
+		client: client,
+		store:  store,
+		sink:   sink,
+		opts:   opts,
+		now:    time.Now,
+	}
+}
+
+// Run syncs every change feed up to now, stopping at the first error.
+func (s *Syncer) Run(ctx context.Context) error {
+	now := s.now().UTC()
+	for _, kind := range s.opts.Kinds {
+		if err := s.runKind(ctx, kind, now); err != nil {
+			return fmt.Errorf("syncing %s changes: %w", kind, err)
+		}
+	}
+	return nil
+}
+
+func (s *Syncer) runKind(ctx context.Context, kind SyncKind, until time.Time) error {
+	changes, ok := s.changesFunc(kind)
+	if !ok {
+		return fmt.Errorf("unknown sync kind %q", kind)
+	}
+
+	checkpoint, ok, err := s.store.Load(ctx, kind)
+	if err != nil {
+		return err
+	}
+	from := checkpoint.Until
+	if !ok {
+		from = s.opts.Since
+		if from.IsZero() {
+			from = until.Add(-24 * time.Hour)
+		}
+	}
+
+	// entities are fetched as they are now, so one fetch per run covers all of their changes
+	seen := map[int32]bool{}
+	for from.Before(until) {
+		to := from.Add(maxChangesWindow)
+		if to.After(until) {
+			to = until
+		}
+
+		changed, err := changes(ctx, from, to)
+		if err != nil {
+			return err
+		}
+
+		var items []ChangedEntity
+		for _, item := range changed {
+			if !seen[item.ID] {
+				seen[item.ID] = true
+				items = append(items, item)
+			}
+		}
+		if err := s.hydrateAll(ctx, kind, items); err != nil {
+			return err
+		}
+
+		if err := s.store.Save(ctx, kind, Checkpoint{Until: to}); err != nil {
+			return err
+		}
+		from = to
+	}
+	return nil
+}
+
+func (s *Syncer) changesFunc(kind SyncKind) (func(ctx context.Context, from, to time.Time, queryParams ...QueryParam) ([]ChangedEntity, error), bool) {
+	switch kind {
+	case SyncMovies:
+		return s.client.Changes.GetMovieChangesBetween, true
+	case SyncTv:
+		return s.client.Changes.GetTVChangesBetween, true
+	case SyncPeople:
+		return s.client.Changes.GetPersonChangesBetween, true
+	}
+	return nil, false
+}
+
+// hydrateAll fetches the details of items and hands them to the sink, with at most
+// opts.Concurrency requests in flight. The first error cancels the remaining items.
+func (s *Syncer) hydrateAll(ctx context.Context, kind SyncKind, items []ChangedEntity) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	jobs := make(chan ChangedEntity)
+	for i := 0; i < s.opts.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for item := range jobs {
+				if err := s.hydrate(ctx, kind, item); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+feed:
+	for _, item := range items {
+		select {
+		case jobs <- item:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// hydrate fetches the details of item and hands them to the sink. Entities TMDb answers
+// with a 404 for are handed over as deleted.
+func (s *Syncer) hydrate(ctx context.Context, kind SyncKind, item ChangedEntity) error {
+	syncItem := SyncItem{Kind: kind, ID: item.ID, Adult: item.Adult, ChangedAt: item.ChangedAt}
+
+	var err error
+	switch kind {
+	case SyncMovies:
+		syncItem.Movie, err = s.client.Movies.GetDetails(ctx, int(item.ID), s.opts.DetailsParams...)
+	case SyncTv:
+		syncItem.TvSeries, err = s.client.TvSeries.GetDetails(ctx, item.ID, s.opts.DetailsParams...)
+	case SyncPeople:
+		syncItem.Person, err = s.client.People.GetDetails(ctx, item.ID, s.opts.DetailsParams...)
+	}
+	if errors.Is(err, ErrNotFound) {
+		syncItem.Deleted, err = true, nil
+	}
+	if err != nil {
+		return fmt.Errorf("fetching %s %d: %w", kind, item.ID, err)
+	}
+
+	return s.sink.Put(ctx, syncItem)
+}
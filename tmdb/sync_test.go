@@ -0,0 +1,152 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newChangesServer serves two pages of movie changes, with movie 3 on both pages and movie 4 deleted.
+func newChangesServer(windows *[]string) (*Client, func()) {
+	var mu sync.Mutex
+	testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/3/movie/changes":
+			query := r.URL.Query()
+			if query.Get("page") == "1" {
+				mu.Lock()
+				*windows = append(*windows, query.Get("start_date")+"/"+query.Get("end_date"))
+				mu.Unlock()
+				_, _ = w.Write([]byte(`{"page":1,"total_pages":2,"changes":[{"id":1,"adult":false},{"id":2,"adult":false},{"id":3,"adult":false}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"page":2,"total_pages":2,"changes":[{"id":3,"adult":false},{"id":4,"adult":true}]}`))
+		case r.URL.Path == "/3/movie/4":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"success":false,"status_code":34,"status_message":"The resource you requested could not be found."}`))
+		case strings.HasPrefix(r.URL.Path, "/3/movie/"):
+			id := strings.TrimPrefix(r.URL.Path, "/3/movie/")
+			_, _ = fmt.Fprintf(w, `{"id":%s,"title":"Movie %s"}`, id, id)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	return testClient, testServer.Close
+}
+
+type recordingSink struct {
+	mu    sync.Mutex
+	items map[int32]SyncItem
+	fail  int32
+}
+
+func (s *recordingSink) Put(ctx context.Context, item SyncItem) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if item.ID == s.fail {
+		return errors.New("sink unavailable")
+	}
+	if s.items == nil {
+		s.items = map[int32]SyncItem{}
+	}
+	if _, ok := s.items[item.ID]; ok {
+		return fmt.Errorf("item %d handed over twice", item.ID)
+	}
+	s.items[item.ID] = item
+	return nil
+}
+
+func TestSyncer(t *testing.T) {
+	now := time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Syncs changed entities in windows", func(t *testing.T) {
+		var windows []string
+		testClient, closeServer := newChangesServer(&windows)
+		defer closeServer()
+
+		store := NewMemoryCheckpointStore()
+		sink := &recordingSink{}
+		syncer := NewSyncer(testClient, store, sink, SyncOptions{
+			Kinds: []SyncKind{SyncMovies},
+			Since: now.Add(-20 * 24 * time.Hour),
+		})
+		syncer.now = func() time.Time { return now }
+
+		if err := syncer.Run(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+
+		expectedWindows := "2024-02-29/2024-03-14,2024-03-14/2024-03-20"
+		if strings.Join(windows, ",") != expectedWindows {
+			t.Errorf("expected windows %s, got %s", expectedWindows, strings.Join(windows, ","))
+		}
+
+		if len(sink.items) != 4 {
+			t.Fatalf("expected 4 items, got %d", len(sink.items))
+		}
+		if sink.items[1].Movie == nil || sink.items[1].Movie.Title != "Movie 1" {
+			t.Errorf("expected details of movie 1, got %+v", sink.items[1])
+		}
+		if !sink.items[4].Deleted || !sink.items[4].Adult || sink.items[4].Movie != nil {
+			t.Errorf("expected movie 4 to be deleted, got %+v", sink.items[4])
+		}
+
+		checkpoint, ok, _ := store.Load(context.Background(), SyncMovies)
+		if !ok || !checkpoint.Until.Equal(now) {
+			t.Errorf("expected checkpoint at %s, got %s", now, checkpoint.Until)
+		}
+	})
+
+	t.Run("Resumes from the checkpoint", func(t *testing.T) {
+		var windows []string
+		testClient, closeServer := newChangesServer(&windows)
+		defer closeServer()
+
+		checkpoint := Checkpoint{Until: now.Add(-48 * time.Hour)}
+		store := NewFileCheckpointStore(filepath.Join(t.TempDir(), "checkpoints.json"))
+		if err := store.Save(context.Background(), SyncMovies, checkpoint); err != nil {
+			t.Fatal(err)
+		}
+
+		sink := &recordingSink{fail: 2}
+		syncer := NewSyncer(testClient, store, sink, SyncOptions{Kinds: []SyncKind{SyncMovies}, Concurrency: 2})
+		syncer.now = func() time.Time { return now }
+
+		if err := syncer.Run(context.Background()); err == nil || !strings.Contains(err.Error(), "sink unavailable") {
+			t.Fatalf("expected the sink error, got %v", err)
+		}
+
+		saved, _, err := store.Load(context.Background(), SyncMovies)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !saved.Until.Equal(checkpoint.Until) {
+			t.Errorf("expected the checkpoint to stay at %s, got %s", checkpoint.Until, saved.Until)
+		}
+
+		sink.fail = 0
+		sink.items = nil
+		if err := syncer.Run(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+
+		if strings.Join(windows, ",") != "2024-03-18/2024-03-20,2024-03-18/2024-03-20" {
+			t.Errorf("expected the failed window to be synced again, got %s", strings.Join(windows, ","))
+		}
+		if len(sink.items) != 4 {
+			t.Errorf("expected 4 items, got %d", len(sink.items))
+		}
+
+		reopened := NewFileCheckpointStore(store.path)
+		saved, ok, err := reopened.Load(context.Background(), SyncMovies)
+		if err != nil || !ok || !saved.Until.Equal(now) {
+			t.Errorf("expected checkpoint at %s, got %s (%v)", now, saved.Until, err)
+		}
+	})
+}
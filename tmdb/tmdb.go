@@ -1,23 +1,29 @@
 package tmdb
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
 	"net/url"
-	"os"
+	"strings"
+	"time"
 )
 
 // this package is the entry point for the tmdb package
 // it has the main http client
 
 const (
-	defaultApiUrl     = "https://api.themoviedb.org"
-	apiVersion        = "3"
-	defaultMaxRetries = 3
+	defaultApiUrl       = "https://api.themoviedb.org"
+	apiVersion          = "3"
+	apiVersion4         = "4"
+	defaultMaxRetries   = 3
+	defaultRetryWaitMin = 500 * time.Millisecond
+	defaultRetryWaitMax = 30 * time.Second
 )
 
 type ClientType string
@@ -36,7 +42,28 @@ type Client struct {
 	token      string
 	logger     *slog.Logger
 
-	maxRetries int
+	maxRetries   int
+	retryWaitMin time.Duration
+	retryWaitMax time.Duration
+	retryMethods map[string]bool
+
+	limiter *rateLimiter
+
+	middlewares []Middleware
+	doer        Doer
+
+	cache     CacheStore
+	cacheTTLs []cacheTTL
+
+	flights *flightGroup
+
+	// userAccessToken is set for clients acting as a user through a v4 access token,
+	// whose responses are never cached as they may hold private data.
+	userAccessToken bool
+
+	skipParamValidation bool
+	extraParams         []extraParams
+	defaultParams       url.Values
 
 	// Services used for talking to different parts of the tmdb API
 	Accounts        AccountService
@@ -67,6 +94,11 @@ type Client struct {
 	TvSeriesLists   TvSeriesListsService
 	TvSeries        TvSeriesService
 	WatchProviders  WatchProvidersService
+
+	// Services of version 4 of the API, which need a bearer token
+	V4Account V4AccountService
+	V4Auth    V4AuthService
+	V4Lists   V4ListsService
 }
 
 // NewClient returns a new tmdb client that adds a bearer auth header to each request
@@ -74,78 +106,45 @@ func NewClientWithBearerAuth(bearerToken string, opts ...ClientOption) (*Client,
 	if bearerToken == "" {
 		return nil, ErrBearerTokenMissing
 	}
-
-	baseUrl, err := url.Parse(defaultApiUrl)
-	if err != nil {
-		return nil, err
-	}
-
-	c := &Client{
-		client:     http.DefaultClient,
-		baseUrl:    baseUrl,
-		maxRetries: defaultMaxRetries,
-		clientType: BearerAuth,
-		token:      bearerToken,
-		logger:     slog.New(slog.NewJSONHandler(os.Stdout, nil)),
-	}
-
-	for _, opt := range opts {
-		opt(c)
-	}
-
-	c.Accounts = &AccountClient{baseClient: c}
-	c.Authentication = &AuthenticationClient{baseClient: c}
-	c.Certifications = &CertificationClient{baseClient: c}
-	c.Changes = &ChangesClient{baseClient: c}
-	c.Collections = &CollectionsClient{baseClient: c}
-	c.Companies = &CompaniesClient{baseClient: c}
-	c.Configuration = &ConfigurationsClient{baseClient: c}
-	c.Credits = &CreditsClient{baseClient: c}
-	c.Discover = &DiscoverClient{baseClient: c}
-	c.Find = &FindClient{baseClient: c}
-	c.Genres = &GenreClient{baseClient: c}
-	c.GuestSessions = &GuestSessionsClient{baseClient: c}
-	c.Keywords = &KeywordsClient{baseClient: c}
-	c.Lists = &ListsClient{baseClient: c}
-	c.MovieLists = &MovieListsClient{baseClient: c}
-	c.Movies = &MoviesClient{baseClient: c}
-	c.Networks = &NetworksClient{baseClient: c}
-	c.PeopleLists = &PeopleListsClient{baseClient: c}
-	c.People = &PeopleClient{baseClient: c}
-	c.Reviews = &ReviewsClient{baseClient: c}
-	c.Search = &SearchClient{baseClient: c}
-	c.Trending = &TrendingClient{baseClient: c}
-	c.TvEpisodeGroups = &TvEpisodeGroupsClient{baseClient: c}
-	c.TvEpisodes = &TvEpisodesClient{baseClient: c}
-	c.TvSeasons = &TvSeasonsClient{baseClient: c}
-	c.TvSeriesLists = &TvSeriesListsClient{baseClient: c}
-	c.TvSeries = &TvSeriesClient{baseClient: c}
-	c.WatchProviders = &WatchProvidersClient{baseClient: c}
-
-	return c, nil
+	return newClient(BearerAuth, bearerToken, opts...)
 }
 
 func NewClientWithApiKey(apiKey string, opts ...ClientOption) (*Client, error) {
 	if apiKey == "" {
 		return nil, ErrApiKeyMissing
 	}
+	return newClient(ApiKey, apiKey, opts...)
+}
 
+func newClient(clientType ClientType, token string, opts ...ClientOption) (*Client, error) {
 	baseUrl, err := url.Parse(defaultApiUrl)
 	if err != nil {
 		return nil, err
 	}
 
 	c := &Client{
-		client:     http.DefaultClient,
-		baseUrl:    baseUrl,
-		clientType: ApiKey,
-		token:      apiKey,
+		client:       http.DefaultClient,
+		baseUrl:      baseUrl,
+		clientType:   clientType,
+		token:        token,
+		maxRetries:   defaultMaxRetries,
+		retryWaitMin: defaultRetryWaitMin,
+		retryWaitMax: defaultRetryWaitMax,
+		retryMethods: defaultRetryMethods(),
+		flights:      newFlightGroup(),
 	}
 
 	for _, opt := range opts {
 		opt(c)
 	}
 
+	c.doer = c.buildDoer()
+	c.initServices()
+
+	return c, nil
+}
+
+func (c *Client) initServices() {
 	c.Accounts = &AccountClient{baseClient: c}
 	c.Authentication = &AuthenticationClient{baseClient: c}
 	c.Certifications = &CertificationClient{baseClient: c}
@@ -174,16 +173,40 @@ func NewClientWithApiKey(apiKey string, opts ...ClientOption) (*Client, error) {
 	c.TvSeriesLists = &TvSeriesListsClient{baseClient: c}
 	c.TvSeries = &TvSeriesClient{baseClient: c}
 	c.WatchProviders = &WatchProvidersClient{baseClient: c}
-
-	return c, nil
+	c.V4Account = &V4AccountClient{baseClient: c}
+	c.V4Auth = &V4AuthClient{baseClient: c}
+	c.V4Lists = &V4ListsClient{baseClient: c}
 }
 
+// WithRetries sets how many times a failed request is retried after the first attempt.
+// Only responses with a 429 or 5xx status and transport errors are retried.
 func WithRetries(maxRetries int) ClientOption {
 	return func(c *Client) {
 		c.maxRetries = maxRetries
 	}
 }
 
+// WithRetryBackoff sets the bounds of the jittered exponential backoff between retries.
+// A Retry-After header sent by TMDB takes precedence over the computed backoff, unless it asks
+// for more than maxWait: the call then fails right away with a TmdbError carrying RetryAfter.
+func WithRetryBackoff(minWait, maxWait time.Duration) ClientOption {
+	return func(c *Client) {
+		c.retryWaitMin = minWait
+		c.retryWaitMax = maxWait
+	}
+}
+
+// WithRetryableMethods replaces the set of HTTP methods that are retried.
+// By default only idempotent methods (GET, HEAD, OPTIONS, PUT and DELETE) are retried.
+func WithRetryableMethods(methods ...string) ClientOption {
+	return func(c *Client) {
+		c.retryMethods = make(map[string]bool, len(methods))
+		for _, m := range methods {
+			c.retryMethods[strings.ToUpper(m)] = true
+		}
+	}
+}
+
 func WithHttpClient(client *http.Client) ClientOption {
 	return func(c *Client) {
 		c.client = client
@@ -196,9 +219,18 @@ func WithBaseUrl(baseUrl *url.URL) ClientOption {
 	}
 }
 
-type queryParam interface {
-	apply(url.Values)
-	getKey() string
+// QueryParam sets query params of a request. Besides SingleQueryParam and MultiQueryParam,
+// typed params such as AppendToResponse or DiscoverMovieOptions implement it. Implementations
+// may also have a Validate() error method, which is called before the request is sent.
+type QueryParam interface {
+	Apply(url.Values)
+	GetKey() string
+}
+
+// validatingParam is implemented by query params that check their values before a request is sent,
+// failing with an error wrapping ErrInvalidQueryParams.
+type validatingParam interface {
+	Validate() error
 }
 
 type SingleQueryParam struct {
@@ -206,11 +238,11 @@ type SingleQueryParam struct {
 	Value interface{}
 }
 
-func (p SingleQueryParam) getKey() string {
+func (p SingleQueryParam) GetKey() string {
 	return p.Key
 }
 
-func (p SingleQueryParam) apply(v url.Values) {
+func (p SingleQueryParam) Apply(v url.Values) {
 	v.Set(p.Key, fmt.Sprintf("%v", p.Value))
 }
 
@@ -219,76 +251,264 @@ type MultiQueryParam struct {
 	Values []interface{}
 }
 
-func (p MultiQueryParam) getKey() string {
+func (p MultiQueryParam) GetKey() string {
 	return p.Key
 }
 
-func (p MultiQueryParam) apply(v url.Values) {
+func (p MultiQueryParam) Apply(v url.Values) {
 	for _, value := range p.Values {
 		v.Add(p.Key, fmt.Sprintf("%v", value))
 	}
 }
 
-func (c *Client) request(ctx context.Context, method, path string, queryParams ...queryParam) (*http.Response, error) {
-	u, err := c.baseUrl.Parse(fmt.Sprintf("/%s/%s", apiVersion, path))
-	if err != nil {
-		return nil, err
+func (c *Client) request(ctx context.Context, method, path string, queryParams ...QueryParam) (*http.Response, error) {
+	return c.requestWithBody(ctx, method, path, nil, queryParams...)
+}
+
+// requestWithBody sends body, unless nil, JSON encoded along with the request.
+func (c *Client) requestWithBody(ctx context.Context, method, path string, body interface{}, queryParams ...QueryParam) (*http.Response, error) {
+	return c.sendRequest(ctx, apiRequest{version: apiVersion, method: method, path: path, body: body, queryParams: queryParams})
+}
+
+// requestV4 sends a request to version 4 of the API, which only accepts bearer tokens.
+func (c *Client) requestV4(ctx context.Context, method, path string, body interface{}, queryParams ...QueryParam) (*http.Response, error) {
+	return c.sendRequest(ctx, apiRequest{version: apiVersion4, method: method, path: path, body: body, queryParams: queryParams})
+}
+
+// apiRequest is a request to the TMDb API. path is relative to the API version.
+type apiRequest struct {
+	version     string
+	method      string
+	path        string
+	body        interface{}
+	queryParams []QueryParam
+}
+
+// endpoint returns the path schemas, defaults and extra params are matched against. v3 paths
+// are used as they are, other versions are prefixed with their number, e.g. /4/list/1.
+func (r apiRequest) endpoint() string {
+	endpoint := "/" + strings.TrimPrefix(r.path, "/")
+	if r.version != apiVersion {
+		endpoint = "/" + r.version + endpoint
 	}
+	return endpoint
+}
 
-	v := url.Values{}
-	for _, param := range queryParams {
-		param.apply(v)
+func (c *Client) sendRequest(ctx context.Context, r apiRequest) (*http.Response, error) {
+	resp, err := c.send(ctx, r)
+	if err != nil {
+		// transport errors embed the full URL, which contains the api key for ApiKey clients
+		return nil, c.redactError(err)
 	}
+	return resp, nil
+}
+
+// WriteResponse is the acknowledgement TMDb sends for writes, such as rating a movie.
+type WriteResponse struct {
+	Success       bool   `json:"success"`
+	StatusCode    int    `json:"status_code"`
+	StatusMessage string `json:"status_message"`
+}
+
+// write sends a POST, PUT or DELETE request with body, unless nil, and decodes the acknowledgement.
+func (c *Client) write(ctx context.Context, method, path string, body interface{}, queryParams ...QueryParam) (*WriteResponse, error) {
+	return decodeWrite(c.requestWithBody(ctx, method, path, body, queryParams...))
+}
+
+// writeV4 is write for version 4 of the API.
+func (c *Client) writeV4(ctx context.Context, method, path string, body interface{}, queryParams ...QueryParam) (*WriteResponse, error) {
+	return decodeWrite(c.requestV4(ctx, method, path, body, queryParams...))
+}
 
-	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+func decodeWrite(resp *http.Response, err error) (*WriteResponse, error) {
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
-	if c.clientType == BearerAuth {
-		req.Header.Set("Authorization", "Bearer "+c.token)
-	} else {
-		v.Set("api_key", c.token)
+	var result WriteResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
 	}
+	return &result, nil
+}
 
-	req.URL.RawQuery = v.Encode()
+func (c *Client) send(ctx context.Context, r apiRequest) (*http.Response, error) {
+	if r.version != apiVersion && c.clientType != BearerAuth {
+		return nil, fmt.Errorf("%w: version %s of the API only accepts bearer tokens", ErrBearerTokenMissing, r.version)
+	}
 
-	resp, err := c.client.Do(req)
+	u, err := c.baseUrl.Parse(fmt.Sprintf("/%s/%s", r.version, strings.TrimPrefix(r.path, "/")))
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO: add rate limiting
+	method := r.method
+	v := url.Values{}
+	for _, param := range r.queryParams {
+		if p, ok := param.(validatingParam); ok {
+			if err := p.Validate(); err != nil {
+				return nil, err
+			}
+		}
+		param.Apply(v)
+	}
 
-	err = c.checkResponse(resp)
-	if err != nil {
+	endpoint := r.endpoint()
+	c.applyDefaults(endpoint, v)
+	if err := c.validateParams(endpoint, v); err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	if c.clientType == ApiKey {
+		v.Set("api_key", c.token)
+	}
+	u.RawQuery = v.Encode()
+
+	// writes are never cached nor coalesced, each one has to reach TMDb
+	if method != http.MethodGet {
+		var header http.Header
+		var payload []byte
+		if r.body != nil {
+			payload, err = json.Marshal(r.body)
+			if err != nil {
+				return nil, err
+			}
+			header = http.Header{"Content-Type": {"application/json;charset=utf-8"}}
+		}
+		return c.do(ctx, method, u, header, payload, true)
+	}
+
+	fetch := func(ctx context.Context) (*CacheEntry, error) {
+		// v4 responses are per user, and some v4 GETs such as clearing a list change state
+		if c.cache != nil && r.version == apiVersion && !c.userAccessToken && cacheableEndpoint(endpoint) && isCacheable(v) {
+			return c.fetchCached(ctx, endpoint, u, v)
+		}
+		resp, err := c.do(ctx, method, u, nil, nil, retryableEndpoint(endpoint))
+		if err != nil {
+			return nil, err
+		}
+		return bufferResponse(resp)
+	}
+
+	if c.flights == nil || !coalescable(endpoint) {
+		entry, err := fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return entry.response(), nil
+	}
+
+	// the token is part of the key so clients sharing the group never share per user data
+	return c.flights.do(ctx, c.token+" "+u.String(), fetch)
+}
+
+// do sends the request, retrying it as configured unless retry is false. header holds extra
+// request headers and body, unless nil, is sent again with every attempt.
+func (c *Client) do(ctx context.Context, method string, u *url.URL, header http.Header, body []byte, retry bool) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		var rateLimitWait time.Duration
+		if c.limiter != nil {
+			wait, err := c.limiter.wait(ctx)
+			if err != nil {
+				return nil, err
+			}
+			rateLimitWait = wait
+		}
+
+		var bodyReader io.Reader
+		if body != nil {
+			bodyReader = bytes.NewReader(body)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, u.String(), bodyReader)
+		if err != nil {
+			return nil, err
+		}
+		for key, values := range header {
+			req.Header[key] = values
+		}
+
+		if c.clientType == BearerAuth {
+			req.Header.Set("Authorization", "Bearer "+c.token)
+		}
+
+		start := time.Now()
+		resp, err := c.doer.Do(req)
+		if err == nil {
+			err = c.checkResponse(resp)
+		}
+
+		a := attemptLog{
+			method:        method,
+			path:          redactPath(u.Path),
+			query:         u.RawQuery,
+			attempt:       attempt,
+			status:        statusOf(resp),
+			latency:       time.Since(start),
+			rateLimitWait: rateLimitWait,
+			err:           err,
+		}
+
+		if err == nil {
+			c.logAttempt(ctx, a)
+			return resp, nil
+		}
+
+		if !retry || attempt >= c.maxRetries || !c.shouldRetry(ctx, method, resp, err) {
+			c.logAttempt(ctx, a)
+			return nil, err
+		}
+
+		retryIn, ok := c.retryWait(attempt, resp)
+		if !ok {
+			c.logAttempt(ctx, a)
+			return nil, err
+		}
+
+		a.retrying = true
+		a.retryIn = retryIn
+		c.logAttempt(ctx, a)
+
+		if err := sleepContext(ctx, a.retryIn); err != nil {
+			return nil, err
+		}
+	}
 }
 
 func (c *Client) checkResponse(resp *http.Response) error {
-	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+	// 304 is only ever sent back to conditional requests made by the cache
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 || resp.StatusCode == http.StatusNotModified {
 		return nil
 	}
 
 	// decode resp.body
+	defer resp.Body.Close()
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return err
 	}
 
-	// decode body into TmdbError
-	var tmdbError TmdbError
-	err = json.Unmarshal(body, &tmdbError)
-	if err != nil {
-		return err
+	tmdbError := TmdbError{
+		HTTPStatus: resp.StatusCode,
+		RawBody:    body,
+	}
+	if resp.Request != nil {
+		tmdbError.Method = resp.Request.Method
+		tmdbError.Path = redactPath(resp.Request.URL.Path)
+	}
+	if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+		tmdbError.RetryAfter = wait
+	}
+
+	// decode body into TmdbError, non JSON bodies (e.g. a CDN error page) only keep the raw body
+	if err := json.Unmarshal(body, &tmdbError); err != nil || tmdbError.StatusMessage == "" {
+		tmdbError.StatusMessage = http.StatusText(resp.StatusCode)
 	}
 	return &tmdbError
 }
 
+// IsTmdbError reports whether err, or any error it wraps, is a *TmdbError.
 func IsTmdbError(err error) bool {
-	_, ok := err.(*TmdbError)
-	return ok
+	var tmdbError *TmdbError
+	return errors.As(err, &tmdbError)
 }
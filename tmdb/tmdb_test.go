@@ -1,11 +1,21 @@
 package tmdb
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
 )
 
 func newTestClientAndServerWithFile(statusCode int, filePath string) (*Client, *httptest.Server, error) {
@@ -27,3 +37,622 @@ func newTestClientAndServerWithFile(statusCode int, filePath string) (*Client, *
 	}
 	return client, server, nil
 }
+
+func newTestClientAndServerWithHandler(handler http.HandlerFunc, opts ...ClientOption) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	baseUrl, _ := url.Parse(server.URL)
+	client, err := NewClientWithBearerAuth("test", append([]ClientOption{WithBaseUrl(baseUrl)}, opts...)...)
+	if err != nil {
+		panic(err)
+	}
+	return client, server
+}
+
+func TestClientRetries(t *testing.T) {
+	t.Run("Retries 5xx until success", func(t *testing.T) {
+		var calls int32
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) < 3 {
+				w.WriteHeader(http.StatusBadGateway)
+				_, _ = w.Write([]byte(`{"status_code":0,"status_message":"bad gateway"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"genres":[{"id":28,"name":"Action"}]}`))
+		}, WithRetryBackoff(time.Millisecond, 5*time.Millisecond))
+		defer testServer.Close()
+
+		result, err := testClient.Genres.GetMovieGenres(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(result.Genres) != 1 {
+			t.Errorf("expected 1 genre, got %d", len(result.Genres))
+		}
+
+		if calls != 3 {
+			t.Errorf("expected 3 calls, got %d", calls)
+		}
+	})
+
+	t.Run("Honors Retry-After on 429", func(t *testing.T) {
+		var calls int32
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				_, _ = w.Write([]byte(`{"status_code":25,"status_message":"rate limited"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"genres":[]}`))
+		}, WithRetryBackoff(time.Hour, time.Hour))
+		defer testServer.Close()
+
+		if _, err := testClient.Genres.GetMovieGenres(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+
+		if calls != 2 {
+			t.Errorf("expected 2 calls, got %d", calls)
+		}
+	})
+
+	t.Run("Does not wait for Retry-After beyond the max backoff", func(t *testing.T) {
+		var calls int32
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.Header().Set("Retry-After", "86400")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"status_code":25,"status_message":"rate limited"}`))
+		}, WithRetryBackoff(time.Millisecond, time.Second))
+		defer testServer.Close()
+
+		start := time.Now()
+		_, err := testClient.Genres.GetMovieGenres(context.Background())
+		var tmdbErr *TmdbError
+		if !errors.As(err, &tmdbErr) || tmdbErr.RetryAfter != 24*time.Hour {
+			t.Errorf("expected a TmdbError with RetryAfter 24h, got %v", err)
+		}
+
+		if calls != 1 || time.Since(start) > time.Second {
+			t.Errorf("expected to give up after 1 call, got %d calls in %s", calls, time.Since(start))
+		}
+	})
+
+	t.Run("Gives up after max retries", func(t *testing.T) {
+		var calls int32
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"status_code":0,"status_message":"unavailable"}`))
+		}, WithRetries(2), WithRetryBackoff(time.Millisecond, time.Millisecond))
+		defer testServer.Close()
+
+		_, err := testClient.Genres.GetMovieGenres(context.Background())
+		if !IsTmdbError(err) {
+			t.Fatalf("expected tmdb error, got %v", err)
+		}
+
+		if calls != 3 {
+			t.Errorf("expected 3 calls, got %d", calls)
+		}
+	})
+
+	t.Run("Does not retry 404", func(t *testing.T) {
+		var calls int32
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"status_code":34,"status_message":"not found"}`))
+		}, WithRetryBackoff(time.Millisecond, time.Millisecond))
+		defer testServer.Close()
+
+		if _, err := testClient.Movies.GetDetails(context.Background(), 1); err == nil {
+			t.Fatal("expected error")
+		}
+
+		if calls != 1 {
+			t.Errorf("expected 1 call, got %d", calls)
+		}
+	})
+
+	t.Run("Stops when context is cancelled", func(t *testing.T) {
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"status_code":11,"status_message":"internal error"}`))
+		}, WithRetryBackoff(time.Hour, time.Hour))
+		defer testServer.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		if _, err := testClient.Genres.GetMovieGenres(ctx); err == nil {
+			t.Fatal("expected error")
+		}
+
+		if time.Since(start) > 5*time.Second {
+			t.Errorf("expected retry wait to be interrupted by the context")
+		}
+	})
+}
+
+func TestClientRateLimit(t *testing.T) {
+	t.Run("Waits for tokens across services", func(t *testing.T) {
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"genres":[]}`))
+		}, WithRateLimit(20, 1))
+		defer testServer.Close()
+
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			if _, err := testClient.Genres.GetMovieGenres(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := testClient.Genres.GetTVGenres(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// 6 requests with a burst of 1 at 20 rps need at least 5 refills of 50ms
+		if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+			t.Errorf("expected requests to be throttled, took %s", elapsed)
+		}
+	})
+
+	t.Run("Fails fast when the context deadline is too short", func(t *testing.T) {
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"genres":[]}`))
+		}, WithRateLimit(0.1, 1))
+		defer testServer.Close()
+
+		if _, err := testClient.Genres.GetMovieGenres(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		_, err := testClient.Genres.GetMovieGenres(ctx)
+		if !errors.Is(err, ErrClientRateLimited) {
+			t.Fatalf("expected ErrClientRateLimited, got %v", err)
+		}
+
+		var waitErr *RateLimitWaitError
+		if !errors.As(err, &waitErr) {
+			t.Fatalf("expected *RateLimitWaitError, got %T", err)
+		}
+
+		if waitErr.Wait < 5*time.Second {
+			t.Errorf("expected a wait of about 10s, got %s", waitErr.Wait)
+		}
+	})
+}
+
+func TestClientErrors(t *testing.T) {
+	t.Run("Not found", func(t *testing.T) {
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"success":false,"status_code":34,"status_message":"The resource you requested could not be found."}`))
+		})
+		defer testServer.Close()
+
+		_, err := testClient.Movies.GetDetails(context.Background(), 1)
+		if !errors.Is(err, ErrNotFound) {
+			t.Fatalf("expected ErrNotFound, got %v", err)
+		}
+
+		var tmdbErr *TmdbError
+		if !errors.As(fmt.Errorf("wrapped: %w", err), &tmdbErr) {
+			t.Fatalf("expected *TmdbError, got %T", err)
+		}
+
+		if tmdbErr.StatusCode != 34 {
+			t.Errorf("expected status code 34, got %d", tmdbErr.StatusCode)
+		}
+
+		if tmdbErr.Method != http.MethodGet || tmdbErr.Path != "/3/movie/1" {
+			t.Errorf("expected GET /3/movie/1, got %s %s", tmdbErr.Method, tmdbErr.Path)
+		}
+	})
+
+	t.Run("Rate limited carries Retry-After", func(t *testing.T) {
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "7")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"status_code":25,"status_message":"Your request count is over the allowed limit."}`))
+		}, WithRetries(0))
+		defer testServer.Close()
+
+		_, err := testClient.Genres.GetMovieGenres(context.Background())
+		if !errors.Is(err, ErrRateLimited) {
+			t.Fatalf("expected ErrRateLimited, got %v", err)
+		}
+
+		var tmdbErr *TmdbError
+		if errors.As(err, &tmdbErr) && tmdbErr.RetryAfter != 7*time.Second {
+			t.Errorf("expected retry after 7s, got %s", tmdbErr.RetryAfter)
+		}
+	})
+
+	t.Run("Non JSON server error", func(t *testing.T) {
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte(`<html><body>502 Bad Gateway</body></html>`))
+		}, WithRetries(0))
+		defer testServer.Close()
+
+		_, err := testClient.Genres.GetMovieGenres(context.Background())
+		if !errors.Is(err, ErrServerError) {
+			t.Fatalf("expected ErrServerError, got %v", err)
+		}
+
+		if errors.Is(err, ErrNotFound) || errors.Is(err, ErrUnauthorized) {
+			t.Errorf("expected only ErrServerError to match")
+		}
+
+		var tmdbErr *TmdbError
+		if errors.As(err, &tmdbErr) && !strings.Contains(string(tmdbErr.RawBody), "502 Bad Gateway") {
+			t.Errorf("expected raw body to be kept, got %q", tmdbErr.RawBody)
+		}
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"status_code":7,"status_message":"Invalid API key: You must be granted a valid key."}`))
+		})
+		defer testServer.Close()
+
+		_, err := testClient.Genres.GetMovieGenres(context.Background())
+		if !errors.Is(err, ErrUnauthorized) {
+			t.Fatalf("expected ErrUnauthorized, got %v", err)
+		}
+	})
+}
+
+func TestClientRedaction(t *testing.T) {
+	t.Run("Transport errors hide the api key", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		baseUrl, _ := url.Parse(server.URL)
+		server.Close()
+
+		testClient, err := NewClientWithApiKey("super-secret-key", WithBaseUrl(baseUrl), WithRetries(0))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = testClient.Movies.GetDetails(context.Background(), 550)
+		if err == nil {
+			t.Fatal("expected error")
+		}
+
+		if strings.Contains(err.Error(), "super-secret-key") {
+			t.Errorf("expected api key to be redacted, got %q", err.Error())
+		}
+
+		var urlErr *url.Error
+		if !errors.As(err, &urlErr) || !strings.Contains(urlErr.URL, "api_key=REDACTED") {
+			t.Errorf("expected redacted *url.Error, got %v", err)
+		}
+	})
+
+	t.Run("Redact URL", func(t *testing.T) {
+		got := redactURL("https://api.themoviedb.org/3/movie/550?api_key=abc&guest_session_id=def&language=en-US&session_id=ghi")
+		want := "https://api.themoviedb.org/3/movie/550?api_key=REDACTED&guest_session_id=REDACTED&language=en-US&session_id=REDACTED"
+		if got != want {
+			t.Errorf("expected %s, got %s", want, got)
+		}
+
+		got = redactURL("https://api.themoviedb.org/3/guest_session/def/rated/movies?api_key=abc")
+		want = "https://api.themoviedb.org/3/guest_session/REDACTED/rated/movies?api_key=REDACTED"
+		if got != want {
+			t.Errorf("expected %s, got %s", want, got)
+		}
+		if got := redactPath("/3/authentication/guest_session/new"); got != "/3/authentication/guest_session/new" {
+			t.Errorf("expected the guest session creation path to be kept, got %s", got)
+		}
+	})
+
+	t.Run("Errors and logs hide guest session IDs in paths", func(t *testing.T) {
+		var buf bytes.Buffer
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"success":false,"status_code":34,"status_message":"The resource you requested could not be found."}`))
+		}, WithLogger(slog.New(slog.NewJSONHandler(&buf, nil))))
+		defer testServer.Close()
+
+		_, err := testClient.GuestSessions.GetRatedMovies(context.Background(), "secretguestid")
+		if !errors.Is(err, ErrNotFound) {
+			t.Fatalf("expected ErrNotFound, got %v", err)
+		}
+		if strings.Contains(err.Error(), "secretguestid") || !strings.Contains(err.Error(), "/3/guest_session/REDACTED/rated/movies") {
+			t.Errorf("expected the guest session ID to be redacted, got %q", err.Error())
+		}
+		if strings.Contains(buf.String(), "secretguestid") {
+			t.Errorf("expected the guest session ID to be redacted from logs, got %s", buf.String())
+		}
+	})
+}
+
+func TestClientLogging(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"status_code":0,"status_message":"unavailable"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"genres":[]}`))
+	}))
+	defer server.Close()
+	baseUrl, _ := url.Parse(server.URL)
+
+	testClient, err := NewClientWithApiKey("super-secret-key", WithBaseUrl(baseUrl), WithLogger(logger), WithRetryBackoff(time.Millisecond, time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := testClient.Genres.GetMovieGenres(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 log records, got %d: %s", len(lines), buf.String())
+	}
+
+	if strings.Contains(buf.String(), "super-secret-key") {
+		t.Errorf("expected api key to be redacted from logs, got %s", buf.String())
+	}
+
+	var record map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[1]), &record); err != nil {
+		t.Fatal(err)
+	}
+
+	if record["service"] != "genre" || record["path"] != "/3/genre/movie/list" {
+		t.Errorf("unexpected service or path in %v", record)
+	}
+
+	if record["status"] != float64(http.StatusOK) || record["attempt"] != float64(1) {
+		t.Errorf("unexpected status or attempt in %v", record)
+	}
+}
+
+func TestClientMiddleware(t *testing.T) {
+	var order []string
+	var calls int32
+
+	tag := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				req.Header.Set("X-Middleware", name)
+				return next.Do(req)
+			})
+		}
+	}
+
+	// fails the first attempt without reaching the server
+	faultInjection := func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				return &http.Response{
+					StatusCode: http.StatusServiceUnavailable,
+					Header:     http.Header{},
+					Body:       io.NopCloser(strings.NewReader(`{"status_message":"injected"}`)),
+					Request:    req,
+				}, nil
+			}
+			return next.Do(req)
+		})
+	}
+
+	testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Middleware"); got != "inner" {
+			t.Errorf("expected inner middleware to run last, got %s", got)
+		}
+		_, _ = w.Write([]byte(`{"genres":[]}`))
+	}, WithMiddleware(tag("outer"), tag("inner")), WithMiddleware(faultInjection), WithRetryBackoff(time.Millisecond, time.Millisecond))
+	defer testServer.Close()
+
+	if _, err := testClient.Genres.GetMovieGenres(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(order, ",") != "outer,inner,outer,inner" {
+		t.Errorf("expected middlewares to run in order once per attempt, got %v", order)
+	}
+}
+
+func TestClientRequestCoalescing(t *testing.T) {
+	t.Run("Identical concurrent requests share one call", func(t *testing.T) {
+		var calls int32
+		release := make(chan struct{})
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			<-release
+			_, _ = w.Write([]byte(`{"id":550,"title":"Fight Club"}`))
+		})
+		defer testServer.Close()
+
+		const waiters = 10
+		results := make(chan *MovieDetailsResponse, waiters)
+		errs := make(chan error, waiters)
+		for i := 0; i < waiters; i++ {
+			go func() {
+				result, err := testClient.Movies.GetDetails(context.Background(), 550)
+				results <- result
+				errs <- err
+			}()
+		}
+
+		// a cancelled waiter must not affect the others
+		ctx, cancel := context.WithCancel(context.Background())
+		cancelled := make(chan error, 1)
+		go func() {
+			_, err := testClient.Movies.GetDetails(ctx, 550)
+			cancelled <- err
+		}()
+
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+		if err := <-cancelled; !errors.Is(err, context.Canceled) {
+			t.Errorf("expected cancelled waiter to return context.Canceled, got %v", err)
+		}
+		close(release)
+
+		seen := map[*MovieDetailsResponse]bool{}
+		for i := 0; i < waiters; i++ {
+			if err := <-errs; err != nil {
+				t.Fatal(err)
+			}
+			result := <-results
+			if result.Title != "Fight Club" {
+				t.Errorf("expected Fight Club, got %s", result.Title)
+			}
+			seen[result] = true
+		}
+
+		if calls != 1 {
+			t.Errorf("expected 1 call, got %d", calls)
+		}
+
+		if len(seen) != waiters {
+			t.Errorf("expected every waiter to get its own copy, got %d distinct results", len(seen))
+		}
+	})
+
+	t.Run("Coalescing can be turned off", func(t *testing.T) {
+		var calls int32
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			time.Sleep(20 * time.Millisecond)
+			_, _ = w.Write([]byte(`{"id":550}`))
+		}, WithRequestCoalescing(false))
+		defer testServer.Close()
+
+		done := make(chan struct{})
+		for i := 0; i < 3; i++ {
+			go func() {
+				_, _ = testClient.Movies.GetDetails(context.Background(), 550)
+				done <- struct{}{}
+			}()
+		}
+		for i := 0; i < 3; i++ {
+			<-done
+		}
+
+		if calls != 3 {
+			t.Errorf("expected 3 calls, got %d", calls)
+		}
+	})
+
+	t.Run("GETs creating state are not coalesced", func(t *testing.T) {
+		var calls int32
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			call := atomic.AddInt32(&calls, 1)
+			time.Sleep(20 * time.Millisecond)
+			_, _ = fmt.Fprintf(w, `{"success":true,"guest_session_id":"guest-%d","expires_at":"2024-05-15 09:00:00 UTC"}`, call)
+		})
+		defer testServer.Close()
+
+		const guests = 5
+		ids := make(chan string, guests)
+		for i := 0; i < guests; i++ {
+			go func() {
+				session, err := testClient.Authentication.CreateGuestSession(context.Background())
+				if err != nil {
+					t.Error(err)
+					ids <- ""
+					return
+				}
+				ids <- session.GuestID
+			}()
+		}
+
+		seen := map[string]bool{}
+		for i := 0; i < guests; i++ {
+			seen[<-ids] = true
+		}
+		if len(seen) != guests || calls != guests {
+			t.Errorf("expected %d distinct guest sessions from %d calls, got %v from %d calls", guests, guests, seen, calls)
+		}
+	})
+}
+
+func TestClientWrites(t *testing.T) {
+	t.Run("Sends JSON bodies and decodes the acknowledgement", func(t *testing.T) {
+		var calls int32
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			body, _ := io.ReadAll(r.Body)
+			if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json;charset=utf-8" || string(body) != `{"value":8.5}` {
+				t.Errorf("unexpected request %s %s %s", r.Method, r.Header.Get("Content-Type"), body)
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"success":true,"status_code":1,"status_message":"Success."}`))
+		}, WithCache(NewLRUCache(10)))
+		defer testServer.Close()
+
+		for i := 0; i < 2; i++ {
+			result, err := testClient.write(context.Background(), http.MethodPost, "/movie/18148/rating", map[string]float64{"value": 8.5})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !result.Success || result.StatusCode != 1 || result.StatusMessage != "Success." {
+				t.Errorf("unexpected acknowledgement %+v", result)
+			}
+		}
+
+		if calls != 2 {
+			t.Errorf("expected every write to reach the server, got %d calls", calls)
+		}
+	})
+
+	t.Run("Does not retry POST", func(t *testing.T) {
+		var calls int32
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusBadGateway)
+		}, WithRetryBackoff(time.Millisecond, 5*time.Millisecond))
+		defer testServer.Close()
+
+		if _, err := testClient.write(context.Background(), http.MethodPost, "/movie/18148/rating", map[string]float64{"value": 8.5}); !errors.Is(err, ErrServerError) {
+			t.Errorf("expected ErrServerError, got %v", err)
+		}
+
+		if calls != 1 {
+			t.Errorf("expected 1 call, got %d", calls)
+		}
+	})
+
+	t.Run("Resends the body on retries", func(t *testing.T) {
+		var calls int32
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != `{"media_id":18148}` {
+				t.Errorf("unexpected body %s", body)
+			}
+			if atomic.AddInt32(&calls, 1) < 2 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			_, _ = w.Write([]byte(`{"success":true,"status_code":13,"status_message":"The item/record was deleted successfully."}`))
+		}, WithRetryBackoff(time.Millisecond, 5*time.Millisecond))
+		defer testServer.Close()
+
+		result, err := testClient.write(context.Background(), http.MethodDelete, "/movie/18148/rating", map[string]int{"media_id": 18148})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.StatusCode != 13 || calls != 2 {
+			t.Errorf("expected the delete to succeed on the second call, got %+v after %d calls", result, calls)
+		}
+	})
+}
@@ -8,10 +8,10 @@ import (
 )
 
 type TrendingService interface {
-	GetAll(ctx context.Context, timeWindow string, queryParams ...queryParam) (*TrendingAllResponse, error)
-	GetMovies(ctx context.Context, timeWindow string, queryParams ...queryParam) (*TrendingMoviesResponse, error)
-	GetTvShows(ctx context.Context, timeWindow string, queryParams ...queryParam) (*TrendingTvShowsResponse, error)
-	GetPeople(ctx context.Context, timeWindow string, queryParams ...queryParam) (*TrendingPeopleResponse, error)
+	GetAll(ctx context.Context, timeWindow string, queryParams ...QueryParam) (*TrendingAllResponse, error)
+	GetMovies(ctx context.Context, timeWindow string, queryParams ...QueryParam) (*TrendingMoviesResponse, error)
+	GetTvShows(ctx context.Context, timeWindow string, queryParams ...QueryParam) (*TrendingTvShowsResponse, error)
+	GetPeople(ctx context.Context, timeWindow string, queryParams ...QueryParam) (*TrendingPeopleResponse, error)
 }
 
 type TrendingClient struct {
@@ -37,65 +37,85 @@ type TrendingResult struct {
 }
 
 type TrendingAllResponse struct {
+	Page         int32        `json:"page"`
+	TotalPages   int32        `json:"total_pages"`
+	TotalResults int32        `json:"total_results"`
+	Results      MediaResults `json:"results"`
+}
+
+func (r *TrendingAllResponse) pageInfo() (page, totalPages int) {
+	return int(r.Page), int(r.TotalPages)
+}
+
+func (r *TrendingAllResponse) results() []MediaResult {
+	return r.Results
+}
+
+type TrendingMoviesResponse struct {
 	Page         int32            `json:"page"`
 	TotalPages   int32            `json:"total_pages"`
 	TotalResults int32            `json:"total_results"`
 	Results      []TrendingResult `json:"results"`
 }
 
-type TrendingMoviesResponse struct {
+func (r *TrendingMoviesResponse) pageInfo() (page, totalPages int) {
+	return int(r.Page), int(r.TotalPages)
+}
+
+func (r *TrendingMoviesResponse) results() []TrendingResult {
+	return r.Results
+}
+
+type TrendingTvShow struct {
+	FirstAirDate  string   `json:"first_air_date"`
+	OriginCountry []string `json:"origin_country"`
+	TrendingResult
+}
+
+type TrendingTvShowsResponse struct {
 	Page         int32            `json:"page"`
 	TotalPages   int32            `json:"total_pages"`
 	TotalResults int32            `json:"total_results"`
-	Results      []TrendingResult `json:"results"`
+	Results      []TrendingTvShow `json:"results"`
 }
 
-type TrendingTvShowsResponse struct {
-	Page         int32 `json:"page"`
-	TotalPages   int32 `json:"total_pages"`
-	TotalResults int32 `json:"total_results"`
-	Results      []struct {
-		FirstAirDate  string   `json:"first_air_date"`
-		OriginCountry []string `json:"origin_country"`
-		TrendingResult
-	} `json:"results"`
+func (r *TrendingTvShowsResponse) pageInfo() (page, totalPages int) {
+	return int(r.Page), int(r.TotalPages)
+}
+
+func (r *TrendingTvShowsResponse) results() []TrendingTvShow {
+	return r.Results
+}
+
+type TrendingPerson struct {
+	Adult              bool         `json:"adult"`
+	Gender             int32        `json:"gender"`
+	ID                 int32        `json:"id"`
+	Name               string       `json:"name"`
+	MediaType          string       `json:"media_type"`
+	OriginalName       string       `json:"original_name"`
+	Popularity         float32      `json:"popularity"`
+	ProfilePath        string       `json:"profile_path"`
+	KnownForDepartment string       `json:"known_for_department"`
+	KnownFor           MediaResults `json:"known_for"`
 }
 
 type TrendingPeopleResponse struct {
-	Page         int32 `json:"page"`
-	TotalPages   int32 `json:"total_pages"`
-	TotalResults int32 `json:"total_results"`
-	Results      []struct {
-		Adult              bool    `json:"adult"`
-		Gender             int32   `json:"gender"`
-		ID                 int32   `json:"id"`
-		Name               string  `json:"name"`
-		MediaType          string  `json:"media_type"`
-		OriginalName       string  `json:"original_name"`
-		Popularity         float32 `json:"popularity"`
-		ProfilePath        string  `json:"profile_path"`
-		KnownForDepartment string  `json:"known_for_department"`
-		KnownFor           []struct {
-			Adult            bool    `json:"adult"`
-			BackdropPath     string  `json:"backdrop_path"`
-			GenreIds         []int32 `json:"genre_ids"`
-			ID               int32   `json:"id"`
-			MediaType        string  `json:"media_type"`
-			OriginalLanguage string  `json:"original_language"`
-			OriginalTitle    string  `json:"original_title"`
-			Overview         string  `json:"overview"`
-			Popularity       float32 `json:"popularity"`
-			PosterPath       string  `json:"poster_path"`
-			ReleaseDate      string  `json:"release_date"`
-			Title            string  `json:"title"`
-			Video            bool    `json:"video"`
-			VoteAverage      float32 `json:"vote_average"`
-			VoteCount        int32   `json:"vote_count"`
-		} `json:"known_for"`
-	} `json:"results"`
-}
-
-func (t *TrendingClient) GetAll(ctx context.Context, timeWindow string, queryParams ...queryParam) (*TrendingAllResponse, error) {
+	Page         int32            `json:"page"`
+	TotalPages   int32            `json:"total_pages"`
+	TotalResults int32            `json:"total_results"`
+	Results      []TrendingPerson `json:"results"`
+}
+
+func (r *TrendingPeopleResponse) pageInfo() (page, totalPages int) {
+	return int(r.Page), int(r.TotalPages)
+}
+
+func (r *TrendingPeopleResponse) results() []TrendingPerson {
+	return r.Results
+}
+
+func (t *TrendingClient) GetAll(ctx context.Context, timeWindow string, queryParams ...QueryParam) (*TrendingAllResponse, error) {
 	resp, err := t.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/trending/all/%s", timeWindow), queryParams...)
 	if err != nil {
 		return nil, err
@@ -109,7 +129,7 @@ func (t *TrendingClient) GetAll(ctx context.Context, timeWindow string, queryPar
 	return &result, nil
 }
 
-func (t *TrendingClient) GetMovies(ctx context.Context, timeWindow string, queryParams ...queryParam) (*TrendingMoviesResponse, error) {
+func (t *TrendingClient) GetMovies(ctx context.Context, timeWindow string, queryParams ...QueryParam) (*TrendingMoviesResponse, error) {
 	resp, err := t.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/trending/movie/%s", timeWindow), queryParams...)
 	if err != nil {
 		return nil, err
@@ -123,7 +143,7 @@ func (t *TrendingClient) GetMovies(ctx context.Context, timeWindow string, query
 	return &result, nil
 }
 
-func (t *TrendingClient) GetTvShows(ctx context.Context, timeWindow string, queryParams ...queryParam) (*TrendingTvShowsResponse, error) {
+func (t *TrendingClient) GetTvShows(ctx context.Context, timeWindow string, queryParams ...QueryParam) (*TrendingTvShowsResponse, error) {
 	resp, err := t.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/trending/tv/%s", timeWindow), queryParams...)
 	if err != nil {
 		return nil, err
@@ -137,7 +157,7 @@ func (t *TrendingClient) GetTvShows(ctx context.Context, timeWindow string, quer
 	return &result, nil
 }
 
-func (t *TrendingClient) GetPeople(ctx context.Context, timeWindow string, queryParams ...queryParam) (*TrendingPeopleResponse, error) {
+func (t *TrendingClient) GetPeople(ctx context.Context, timeWindow string, queryParams ...QueryParam) (*TrendingPeopleResponse, error) {
 	resp, err := t.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/trending/people/%s", timeWindow), queryParams...)
 	if err != nil {
 		return nil, err
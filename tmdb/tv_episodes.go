@@ -8,14 +8,17 @@ import (
 )
 
 type TvEpisodesService interface {
-	GetDetails(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...queryParam) (*TvEpisodesDetailsResponse, error)
-	GetAccountStates(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...queryParam) (*TvEpisodesAccountStatesResponse, error)
-	GetChanges(ctx context.Context, episodeID int32) (*TvEpisodesChangesResponse, error)
-	GetCredits(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...queryParam) (*TvEpisodesCreditsResponse, error)
+	GetDetails(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...QueryParam) (*TvEpisodesDetailsResponse, error)
+	GetDetailsWith(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...QueryParam) (*TvEpisodesDetailsResponse, error)
+	GetAccountStates(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...QueryParam) (*TvEpisodesAccountStatesResponse, error)
+	AddRating(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, rating float64, queryParams ...QueryParam) (*WriteResponse, error)
+	DeleteRating(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...QueryParam) (*WriteResponse, error)
+	GetChanges(ctx context.Context, episodeID int32, queryParams ...QueryParam) (*TvEpisodesChangesResponse, error)
+	GetCredits(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...QueryParam) (*TvEpisodesCreditsResponse, error)
 	GetExternalIDs(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32) (*TvEpisodesExternalIDsResponse, error)
-	GetImages(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...queryParam) (*TvEpisodesImagesResponse, error)
+	GetImages(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...QueryParam) (*TvEpisodesImagesResponse, error)
 	GetTranslations(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32) (*TvEpisodesTranslationsResponse, error)
-	GetVideos(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...queryParam) (*TvEpisodesVideosResponse, error)
+	GetVideos(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...QueryParam) (*TvEpisodesVideosResponse, error)
 }
 
 type TvEpisodesClient struct {
@@ -61,6 +64,22 @@ type TvEpisodesDetailsResponse struct {
 	VoteAverage    float32 `json:"vote_average"`
 	VoteCount      int32   `json:"vote_count"`
 	Runtime        int32   `json:"runtime"`
+
+	// Appended sub-resources, only set when requested through GetDetailsWith.
+	Credits      *TvEpisodesCreditsResponse      `json:"credits,omitempty"`
+	ExternalIDs  *TvEpisodesExternalIDsResponse  `json:"external_ids,omitempty"`
+	Images       *TvEpisodesImagesResponse       `json:"images,omitempty"`
+	Translations *TvEpisodesTranslationsResponse `json:"translations,omitempty"`
+	Videos       *TvEpisodesVideosResponse       `json:"videos,omitempty"`
+}
+
+// tvEpisodesDetailsAppends are the sub-resources TvEpisodesDetailsResponse can hold.
+var tvEpisodesDetailsAppends = []AppendToResponse{
+	AppendCredits,
+	AppendExternalIDs,
+	AppendImages,
+	AppendTranslations,
+	AppendVideos,
 }
 
 // TvEpisodesAccountStatesResponse struct is based off of https://developer.themoviedb.org/reference/tv-episode-account-states
@@ -75,15 +94,7 @@ type TvEpisodesAccountStatesResponse struct {
 
 // TvEpisodesChangesResponse struct is based off of https://developer.themoviedb.org/reference/tv-episode-changes-by-id
 type TvEpisodesChangesResponse struct {
-	Changes []struct {
-		Key   string `json:"key"`
-		Items []struct {
-			ID     string `json:"id"`
-			Action string `json:"action"`
-			Time   string `json:"time"`
-			Value  string `json:"value"`
-		} `json:"items"`
-	} `json:"changes"`
+	Changes []Change `json:"changes"`
 }
 
 // TvEpisodesCreditsResponse struct is based off of https://developer.themoviedb.org/reference/tv-episode-credits
@@ -187,7 +198,7 @@ type TvEpisodesVideosResponse struct {
 	} `json:"results"`
 }
 
-func (tc *TvEpisodesClient) GetDetails(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...queryParam) (*TvEpisodesDetailsResponse, error) {
+func (tc *TvEpisodesClient) GetDetails(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...QueryParam) (*TvEpisodesDetailsResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/%d/season/%d/episode/%d", seriesID, seasonNumber, episodeNumber), queryParams...)
 	if err != nil {
 		return nil, err
@@ -201,7 +212,16 @@ func (tc *TvEpisodesClient) GetDetails(ctx context.Context, seriesID int32, seas
 	return &result, nil
 }
 
-func (tc *TvEpisodesClient) GetAccountStates(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...queryParam) (*TvEpisodesAccountStatesResponse, error) {
+// GetDetailsWith fetches the details of a tv episode together with the given AppendToResponse
+// sub-resources in a single request. Other query params, e.g. language, can be mixed in.
+func (tc *TvEpisodesClient) GetDetailsWith(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...QueryParam) (*TvEpisodesDetailsResponse, error) {
+	if err := checkAppends("tv episode details", tvEpisodesDetailsAppends, queryParams); err != nil {
+		return nil, err
+	}
+	return tc.GetDetails(ctx, seriesID, seasonNumber, episodeNumber, queryParams...)
+}
+
+func (tc *TvEpisodesClient) GetAccountStates(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...QueryParam) (*TvEpisodesAccountStatesResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/%d/season/%d/episode/%d/account_states", seriesID, seasonNumber, episodeNumber), queryParams...)
 	if err != nil {
 		return nil, err
@@ -215,8 +235,8 @@ func (tc *TvEpisodesClient) GetAccountStates(ctx context.Context, seriesID int32
 	return &result, nil
 }
 
-func (tc *TvEpisodesClient) GetChanges(ctx context.Context, episodeID int32) (*TvEpisodesChangesResponse, error) {
-	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/episode/%d/changes", episodeID))
+func (tc *TvEpisodesClient) GetChanges(ctx context.Context, episodeID int32, queryParams ...QueryParam) (*TvEpisodesChangesResponse, error) {
+	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/episode/%d/changes", episodeID), queryParams...)
 	if err != nil {
 		return nil, err
 	}
@@ -229,7 +249,7 @@ func (tc *TvEpisodesClient) GetChanges(ctx context.Context, episodeID int32) (*T
 	return &result, nil
 }
 
-func (tc *TvEpisodesClient) GetCredits(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...queryParam) (*TvEpisodesCreditsResponse, error) {
+func (tc *TvEpisodesClient) GetCredits(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...QueryParam) (*TvEpisodesCreditsResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/%d/season/%d/episode/%d/credits", seriesID, seasonNumber, episodeNumber), queryParams...)
 	if err != nil {
 		return nil, err
@@ -257,7 +277,7 @@ func (tc *TvEpisodesClient) GetExternalIDs(ctx context.Context, seriesID int32,
 	return &result, nil
 }
 
-func (tc *TvEpisodesClient) GetImages(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...queryParam) (*TvEpisodesImagesResponse, error) {
+func (tc *TvEpisodesClient) GetImages(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...QueryParam) (*TvEpisodesImagesResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/%d/season/%d/episode/%d/images", seriesID, seasonNumber, episodeNumber), queryParams...)
 	if err != nil {
 		return nil, err
@@ -285,7 +305,7 @@ func (tc *TvEpisodesClient) GetTranslations(ctx context.Context, seriesID int32,
 	return &result, nil
 }
 
-func (tc *TvEpisodesClient) GetVideos(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...queryParam) (*TvEpisodesVideosResponse, error) {
+func (tc *TvEpisodesClient) GetVideos(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...QueryParam) (*TvEpisodesVideosResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/%d/season/%d/episode/%d/videos", seriesID, seasonNumber, episodeNumber), queryParams...)
 	if err != nil {
 		return nil, err
@@ -298,3 +318,22 @@ func (tc *TvEpisodesClient) GetVideos(ctx context.Context, seriesID int32, seaso
 	}
 	return &result, nil
 }
+
+// AddRating rates the episode between 0.5 and 10 in steps of 0.5, as the user of a SessionID or GuestSessionID.
+func (tc *TvEpisodesClient) AddRating(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, rating float64, queryParams ...QueryParam) (*WriteResponse, error) {
+	if err := validateRating(rating); err != nil {
+		return nil, err
+	}
+	if err := tc.baseClient.requireSession(queryParams, "session_id", "guest_session_id"); err != nil {
+		return nil, err
+	}
+	return tc.baseClient.write(ctx, http.MethodPost, fmt.Sprintf("/tv/%d/season/%d/episode/%d/rating", seriesID, seasonNumber, episodeNumber), ratingBody{Value: rating}, queryParams...)
+}
+
+// DeleteRating removes the rating of the episode by the user of a SessionID or GuestSessionID.
+func (tc *TvEpisodesClient) DeleteRating(ctx context.Context, seriesID int32, seasonNumber int32, episodeNumber int32, queryParams ...QueryParam) (*WriteResponse, error) {
+	if err := tc.baseClient.requireSession(queryParams, "session_id", "guest_session_id"); err != nil {
+		return nil, err
+	}
+	return tc.baseClient.write(ctx, http.MethodDelete, fmt.Sprintf("/tv/%d/season/%d/episode/%d/rating", seriesID, seasonNumber, episodeNumber), nil, queryParams...)
+}
@@ -8,16 +8,17 @@ import (
 )
 
 type TvSeasonsService interface {
-	GetDetails(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...queryParam) (*TvSeasonsDetailsResponse, error)
-	GetAccountStates(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...queryParam) (*TvSeasonsAccountStatesResponse, error)
-	GetAggregateCredits(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...queryParam) (*TvSeasonsAggregateCreditsResponse, error)
-	GetChanges(ctx context.Context, seasonID int32, queryParams ...queryParam) (*TvSeasonsChangesResponse, error)
-	GetCredits(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...queryParam) (*TvSeasonsCreditsResponse, error)
+	GetDetails(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...QueryParam) (*TvSeasonsDetailsResponse, error)
+	GetDetailsWith(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...QueryParam) (*TvSeasonsDetailsResponse, error)
+	GetAccountStates(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...QueryParam) (*TvSeasonsAccountStatesResponse, error)
+	GetAggregateCredits(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...QueryParam) (*TvSeasonsAggregateCreditsResponse, error)
+	GetChanges(ctx context.Context, seasonID int32, queryParams ...QueryParam) (*TvSeasonsChangesResponse, error)
+	GetCredits(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...QueryParam) (*TvSeasonsCreditsResponse, error)
 	GetExternalIds(ctx context.Context, seriesID int32, seasonNumber int32) (*TvSeasonsExternalIdsResponse, error)
-	GetImages(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...queryParam) (*TvSeasonsImagesResponse, error)
+	GetImages(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...QueryParam) (*TvSeasonsImagesResponse, error)
 	GetTranslations(ctx context.Context, seriesID int32, seasonNumber int32) (*TvSeasonsTranslationsResponse, error)
-	GetVideos(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...queryParam) (*TvSeasonsVideosResponse, error)
-	// GetWatchProviders(ctx context.Context, seasonID int32, queryParams ...queryParam) (*TvSeasonsWatchProvidersResponse, error)
+	GetVideos(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...QueryParam) (*TvSeasonsVideosResponse, error)
+	// GetWatchProviders(ctx context.Context, seasonID int32, queryParams ...QueryParam) (*TvSeasonsWatchProvidersResponse, error)
 }
 
 type TvSeasonsClient struct {
@@ -74,6 +75,24 @@ type TvSeasonsDetailsResponse struct {
 	PosterPath   string  `json:"poster_path"`
 	SeasonNumber int32   `json:"season_number"`
 	VoteAverage  float32 `json:"vote_average"`
+
+	// Appended sub-resources, only set when requested through GetDetailsWith.
+	AggregateCredits *TvSeasonsAggregateCreditsResponse `json:"aggregate_credits,omitempty"`
+	Credits          *TvSeasonsCreditsResponse          `json:"credits,omitempty"`
+	ExternalIDs      *TvSeasonsExternalIdsResponse      `json:"external_ids,omitempty"`
+	Images           *TvSeasonsImagesResponse           `json:"images,omitempty"`
+	Translations     *TvSeasonsTranslationsResponse     `json:"translations,omitempty"`
+	Videos           *TvSeasonsVideosResponse           `json:"videos,omitempty"`
+}
+
+// tvSeasonsDetailsAppends are the sub-resources TvSeasonsDetailsResponse can hold.
+var tvSeasonsDetailsAppends = []AppendToResponse{
+	AppendAggregateCredits,
+	AppendCredits,
+	AppendExternalIDs,
+	AppendImages,
+	AppendTranslations,
+	AppendVideos,
 }
 
 // TvSeasonsAccountStatesResponse struct is based off of https://developers.themoviedb.org/3/tv-seasons/tv-season-account-states
@@ -129,18 +148,7 @@ type TvSeasonsAggregateCreditsResponse struct {
 
 // TvSeasonsChangesResponse struct is based off of https://developers.themoviedb.org/3/tv-seasons/tv-season-changes
 type TvSeasonsChangesResponse struct {
-	Changes []struct {
-		Key   string `json:"key"`
-		Items []struct {
-			ID     string `json:"id"`
-			Action string `json:"action"`
-			Time   string `json:"time"`
-			Value  struct {
-				EpisodeID     int32 `json:"episode_id"`
-				EpisodeNumber int32 `json:"episode_number"`
-			} `json:"value"`
-		} `json:"items"`
-	} `json:"changes"`
+	Changes []Change `json:"changes"`
 }
 
 // TvSeasonsCreditsResponse struct is based off of https://developers.themoviedb.org/3/tv-seasons/tv-season-credits
@@ -180,7 +188,7 @@ type TvSeasonsExternalIdsResponse struct {
 	FreebaseID  string `json:"freebase_id"`
 	FreebaseMid string `json:"freebase_mid"`
 	TvdbID      int32  `json:"tvdb_id"`
-	TvrageID    string `json:"tvrage_id"`
+	TvrageID    int32  `json:"tvrage_id"`
 	WikidataID  string `json:"wikidata_id"`
 }
 
@@ -206,7 +214,7 @@ type TvSeasonsTranslationsResponse struct {
 		Iso_639_1   string `json:"iso_639_1"`
 		Name        string `json:"name"`
 		EnglishName string `json:"english_name"`
-		Data        []struct {
+		Data        struct {
 			Name     string `json:"name"`
 			Overview string `json:"overview"`
 		} `json:"data"`
@@ -233,7 +241,7 @@ type TvSeasonsVideosResponse struct {
 // TvSeasonsWatchProvidersResponse struct is based off of https://developers.themoviedb.org/3/tv-seasons/get-tv-season-watch-providers
 // TODO: figure this out
 
-func (tc *TvSeasonsClient) GetDetails(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...queryParam) (*TvSeasonsDetailsResponse, error) {
+func (tc *TvSeasonsClient) GetDetails(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...QueryParam) (*TvSeasonsDetailsResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/%d/season/%d", seriesID, seasonNumber), queryParams...)
 	if err != nil {
 		return nil, err
@@ -247,7 +255,16 @@ func (tc *TvSeasonsClient) GetDetails(ctx context.Context, seriesID int32, seaso
 	return &result, nil
 }
 
-func (tc *TvSeasonsClient) GetAccountStates(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...queryParam) (*TvSeasonsAccountStatesResponse, error) {
+// GetDetailsWith fetches the details of a tv season together with the given AppendToResponse
+// sub-resources in a single request. Other query params, e.g. language, can be mixed in.
+func (tc *TvSeasonsClient) GetDetailsWith(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...QueryParam) (*TvSeasonsDetailsResponse, error) {
+	if err := checkAppends("tv season details", tvSeasonsDetailsAppends, queryParams); err != nil {
+		return nil, err
+	}
+	return tc.GetDetails(ctx, seriesID, seasonNumber, queryParams...)
+}
+
+func (tc *TvSeasonsClient) GetAccountStates(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...QueryParam) (*TvSeasonsAccountStatesResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/%d/season/%d/account_states", seriesID, seasonNumber), queryParams...)
 	if err != nil {
 		return nil, err
@@ -261,7 +278,7 @@ func (tc *TvSeasonsClient) GetAccountStates(ctx context.Context, seriesID int32,
 	return &result, nil
 }
 
-func (tc *TvSeasonsClient) GetAggregateCredits(ctx context.Context, seasonID int32, seasonNumber int32, queryParams ...queryParam) (*TvSeasonsAggregateCreditsResponse, error) {
+func (tc *TvSeasonsClient) GetAggregateCredits(ctx context.Context, seasonID int32, seasonNumber int32, queryParams ...QueryParam) (*TvSeasonsAggregateCreditsResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/%d/season/%d/aggregate_credits", seasonID, seasonNumber), queryParams...)
 	if err != nil {
 		return nil, err
@@ -275,8 +292,8 @@ func (tc *TvSeasonsClient) GetAggregateCredits(ctx context.Context, seasonID int
 	return &result, nil
 }
 
-func (tc *TvSeasonsClient) GetChanges(ctx context.Context, seasonID int32, queryParams ...queryParam) (*TvSeasonsChangesResponse, error) {
-	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/%d/season/changes", seasonID), queryParams...)
+func (tc *TvSeasonsClient) GetChanges(ctx context.Context, seasonID int32, queryParams ...QueryParam) (*TvSeasonsChangesResponse, error) {
+	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/season/%d/changes", seasonID), queryParams...)
 	if err != nil {
 		return nil, err
 	}
@@ -289,7 +306,7 @@ func (tc *TvSeasonsClient) GetChanges(ctx context.Context, seasonID int32, query
 	return &result, nil
 }
 
-func (tc *TvSeasonsClient) GetCredits(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...queryParam) (*TvSeasonsCreditsResponse, error) {
+func (tc *TvSeasonsClient) GetCredits(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...QueryParam) (*TvSeasonsCreditsResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/%d/season/%d/credits", seriesID, seasonNumber), queryParams...)
 	if err != nil {
 		return nil, err
@@ -317,7 +334,7 @@ func (tc *TvSeasonsClient) GetExternalIds(ctx context.Context, seriesID int32, s
 	return &result, nil
 }
 
-func (tc *TvSeasonsClient) GetImages(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...queryParam) (*TvSeasonsImagesResponse, error) {
+func (tc *TvSeasonsClient) GetImages(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...QueryParam) (*TvSeasonsImagesResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/%d/season/%d/images", seriesID, seasonNumber), queryParams...)
 	if err != nil {
 		return nil, err
@@ -345,7 +362,7 @@ func (tc *TvSeasonsClient) GetTranslations(ctx context.Context, seriesID int32,
 	return &result, nil
 }
 
-func (tc *TvSeasonsClient) GetVideos(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...queryParam) (*TvSeasonsVideosResponse, error) {
+func (tc *TvSeasonsClient) GetVideos(ctx context.Context, seriesID int32, seasonNumber int32, queryParams ...QueryParam) (*TvSeasonsVideosResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/%d/season/%d/videos", seriesID, seasonNumber), queryParams...)
 	if err != nil {
 		return nil, err
@@ -8,24 +8,27 @@ import (
 )
 
 type TvSeriesService interface {
-	GetDetails(ctx context.Context, seriesID int32, queryParams ...queryParam) (*TvSeriesDetailsResponse, error)
-	GetAccountStates(ctx context.Context, seriesID int32, queryParams ...queryParam) (*TvSeriesAccountStatesResponse, error)
-	GetAggregateCredits(ctx context.Context, seriesID int32, queryParams ...queryParam) (*TvSeriesAggregateCreditsResponse, error)
+	GetDetails(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*TvSeriesDetailsResponse, error)
+	GetDetailsWith(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*TvSeriesDetailsResponse, error)
+	GetAccountStates(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*TvSeriesAccountStatesResponse, error)
+	AddRating(ctx context.Context, seriesID int32, rating float64, queryParams ...QueryParam) (*WriteResponse, error)
+	DeleteRating(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*WriteResponse, error)
+	GetAggregateCredits(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*TvSeriesAggregateCreditsResponse, error)
 	GetAlternativeTitles(ctx context.Context, seriesID int32) (*TvSeriesAlternativeTitlesResponse, error)
-	GetChanges(ctx context.Context, seriesID int32, queryParams ...queryParam) (*TvSeriesChangesResponse, error)
+	GetChanges(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*TvSeriesChangesResponse, error)
 	GetContentRatings(ctx context.Context, seriesID int32) (*TvSeriesContentRatingsResponse, error)
-	GetCredits(ctx context.Context, seriesID int32, queryParams ...queryParam) (*TvSeriesCreditsResponse, error)
+	GetCredits(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*TvSeriesCreditsResponse, error)
 	GetEpisodeGroups(ctx context.Context, seriesID int32) (*TvSeriesEpisodeGroupsResponse, error)
 	GetExternalIds(ctx context.Context, seriesID int32) (*TvSeriesExternalIdsResponse, error)
-	GetImages(ctx context.Context, seriesID int32, queryParams ...queryParam) (*TvSeriesImagesResponse, error)
+	GetImages(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*TvSeriesImagesResponse, error)
 	GetKeywords(ctx context.Context, seriesID int32) (*TvSeriesKeywordsResponse, error)
 	GetLatest(ctx context.Context) (*TvSeriesLatestResponse, error)
-	GetRecommendations(ctx context.Context, seriesID int32, queryParams ...queryParam) (*TvSeriesRecommendationsResponse, error)
-	GetReviews(ctx context.Context, seriesID int32, queryParams ...queryParam) (*TvSeriesReviewsResponse, error)
+	GetRecommendations(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*TvSeriesRecommendationsResponse, error)
+	GetReviews(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*TvSeriesReviewsResponse, error)
 	GetScreenedTheatrically(ctx context.Context, seriesID int32) (*TvSeriesScreenedTheatricallyResponse, error)
-	GetSimilar(ctx context.Context, seriesID int32, queryParams ...queryParam) (*TvSeriesSimilarResponse, error)
+	GetSimilar(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*TvSeriesSimilarResponse, error)
 	GetTranslations(ctx context.Context, seriesID int32) (*TvSeriesTranslationsResponse, error)
-	GetVideos(ctx context.Context, seriesID int32, queryParams ...queryParam) (*TvSeriesVideosResponse, error)
+	GetVideos(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*TvSeriesVideosResponse, error)
 	// GetWatchProviders(ctx context.Context, seriesID int32) (*TvSeriesWatchProvidersResponse, error)
 }
 
@@ -114,6 +117,40 @@ type TvSeriesDetailsResponse struct {
 	Type        string  `json:"type"`
 	VoteAverage float32 `json:"vote_average"`
 	VoteCount   int32   `json:"vote_count"`
+
+	// Appended sub-resources, only set when requested through GetDetailsWith.
+	AggregateCredits     *TvSeriesAggregateCreditsResponse     `json:"aggregate_credits,omitempty"`
+	AlternativeTitles    *TvSeriesAlternativeTitlesResponse    `json:"alternative_titles,omitempty"`
+	ContentRatings       *TvSeriesContentRatingsResponse       `json:"content_ratings,omitempty"`
+	Credits              *TvSeriesCreditsResponse              `json:"credits,omitempty"`
+	EpisodeGroups        *TvSeriesEpisodeGroupsResponse        `json:"episode_groups,omitempty"`
+	ExternalIDs          *TvSeriesExternalIdsResponse          `json:"external_ids,omitempty"`
+	Images               *TvSeriesImagesResponse               `json:"images,omitempty"`
+	Keywords             *TvSeriesKeywordsResponse             `json:"keywords,omitempty"`
+	Recommendations      *TvSeriesRecommendationsResponse      `json:"recommendations,omitempty"`
+	Reviews              *TvSeriesReviewsResponse              `json:"reviews,omitempty"`
+	ScreenedTheatrically *TvSeriesScreenedTheatricallyResponse `json:"screened_theatrically,omitempty"`
+	Similar              *TvSeriesSimilarResponse              `json:"similar,omitempty"`
+	Translations         *TvSeriesTranslationsResponse         `json:"translations,omitempty"`
+	Videos               *TvSeriesVideosResponse               `json:"videos,omitempty"`
+}
+
+// tvSeriesDetailsAppends are the sub-resources TvSeriesDetailsResponse can hold.
+var tvSeriesDetailsAppends = []AppendToResponse{
+	AppendAggregateCredits,
+	AppendAlternativeTitles,
+	AppendContentRatings,
+	AppendCredits,
+	AppendEpisodeGroups,
+	AppendExternalIDs,
+	AppendImages,
+	AppendKeywords,
+	AppendRecommendations,
+	AppendReviews,
+	AppendScreenedTheatrically,
+	AppendSimilar,
+	AppendTranslations,
+	AppendVideos,
 }
 
 type TvSeriesAccountStatesResponse struct {
@@ -173,28 +210,7 @@ type TvSeriesAlternativeTitlesResponse struct {
 }
 
 type TvSeriesChangesResponse struct {
-	Changes []struct {
-		Key   string `json:"key"`
-		Items []struct {
-			ID         string `json:"id"`
-			Action     string `json:"action"`
-			Time       string `json:"time"`
-			Iso_639_1  string `json:"iso_639_1"`
-			Iso_3166_1 string `json:"iso_3166_1"`
-			Value      struct {
-				Poster struct {
-					Filepath  string `json:"file_path"`
-					Iso_639_1 string `json:"iso_639_1"`
-				} `json:"poster"`
-			} `json:"value"`
-			OriginalValue struct {
-				Poster struct {
-					Filepath  string `json:"file_path"`
-					Iso_639_1 string `json:"iso_639_1"`
-				} `json:"poster"`
-			} `json:"original_value"`
-		} `json:"items"`
-	} `json:"changes"`
+	Changes []Change `json:"changes"`
 }
 
 type TvSeriesContentRatingsResponse struct {
@@ -371,48 +387,68 @@ type TvSeriesLatestResponse struct {
 	VoteCount   int32   `json:"vote_count"`
 }
 
+type TvSeriesRecommendation struct {
+	Adult            bool     `json:"adult"`
+	BackdropPath     string   `json:"backdrop_path"`
+	GenreIds         []int32  `json:"genre_ids"`
+	ID               int32    `json:"id"`
+	MediaType        string   `json:"media_type"`
+	Name             string   `json:"name"`
+	OriginalLanguage string   `json:"original_language"`
+	OriginalName     string   `json:"original_name"`
+	Overview         string   `json:"overview"`
+	Popularity       float32  `json:"popularity"`
+	PosterPath       string   `json:"poster_path"`
+	FirstAirDate     string   `json:"first_air_date"`
+	OriginCountry    []string `json:"origin_country"`
+	VoteAverage      float32  `json:"vote_average"`
+	VoteCount        int32    `json:"vote_count"`
+}
+
 type TvSeriesRecommendationsResponse struct {
-	Page         int32 `json:"page"`
-	TotalPages   int32 `json:"total_pages"`
-	TotalResults int32 `json:"total_results"`
-	Results      []struct {
-		Adult            bool     `json:"adult"`
-		BackdropPath     string   `json:"backdrop_path"`
-		GenreIds         []int32  `json:"genre_ids"`
-		ID               int32    `json:"id"`
-		MediaType        string   `json:"media_type"`
-		Name             string   `json:"name"`
-		OriginalLanguage string   `json:"original_language"`
-		OriginalName     string   `json:"original_name"`
-		Overview         string   `json:"overview"`
-		Popularity       float32  `json:"popularity"`
-		PosterPath       string   `json:"poster_path"`
-		FirstAirDate     string   `json:"first_air_date"`
-		OriginCountry    []string `json:"origin_country"`
-		VoteAverage      float32  `json:"vote_average"`
-		VoteCount        int32    `json:"vote_count"`
-	} `json:"results"`
+	Page         int32                    `json:"page"`
+	TotalPages   int32                    `json:"total_pages"`
+	TotalResults int32                    `json:"total_results"`
+	Results      []TvSeriesRecommendation `json:"results"`
+}
+
+func (r *TvSeriesRecommendationsResponse) pageInfo() (page, totalPages int) {
+	return int(r.Page), int(r.TotalPages)
+}
+
+func (r *TvSeriesRecommendationsResponse) results() []TvSeriesRecommendation {
+	return r.Results
+}
+
+type TvSeriesReview struct {
+	Author        string `json:"author"`
+	AuthorDetails struct {
+		Name       string  `json:"name"`
+		Username   string  `json:"username"`
+		AvatarPath string  `json:"avatar_path"`
+		Rating     float32 `json:"rating"`
+	} `json:"author_details"`
+	Content   string `json:"content"`
+	CreatedAt string `json:"created_at"`
+	ID        string `json:"id"`
+	UpdatedAt string `json:"updated_at"`
+	URL       string `json:"url"`
 }
 
 type TvSeriesReviewsResponse struct {
-	ID      int32 `json:"id"`
-	Page    int32 `json:"page"`
-	Results []struct {
-		Author        string `json:"author"`
-		AuthorDetails struct {
-			Name       string `json:"name"`
-			Username   string `json:"username"`
-			AvatarPath string `json:"avatar_path"`
-			Rating     int32  `json:"rating"`
-		} `json:"author_details"`
-		Content   string `json:"content"`
-		CreatedAt string `json:"created_at"`
-		ID        string `json:"id"`
-		UpdatedAt string `json:"updated_at"`
-		URL       string `json:"url"`
-	} `json:"results"`
-	TotalPages   int32 `json:"total_pages"`
-	TotalResults int32 `json:"total_results"`
+	ID           int32            `json:"id"`
+	Page         int32            `json:"page"`
+	Results      []TvSeriesReview `json:"results"`
+	TotalPages   int32            `json:"total_pages"`
+	TotalResults int32            `json:"total_results"`
+}
+
+func (r *TvSeriesReviewsResponse) pageInfo() (page, totalPages int) {
+	return int(r.Page), int(r.TotalPages)
+}
+
+func (r *TvSeriesReviewsResponse) results() []TvSeriesReview {
+	return r.Results
 }
 
 type TvSeriesScreenedTheatricallyResponse struct {
@@ -424,27 +460,37 @@ type TvSeriesScreenedTheatricallyResponse struct {
 	} `json:"results"`
 }
 
+type SimilarTvSeries struct {
+	Adult            bool     `json:"adult"`
+	BackdropPath     string   `json:"backdrop_path"`
+	GenreIds         []int32  `json:"genre_ids"`
+	ID               int32    `json:"id"`
+	MediaType        string   `json:"media_type"`
+	Name             string   `json:"name"`
+	OriginalLanguage string   `json:"original_language"`
+	OriginalName     string   `json:"original_name"`
+	Overview         string   `json:"overview"`
+	Popularity       float32  `json:"popularity"`
+	PosterPath       string   `json:"poster_path"`
+	FirstAirDate     string   `json:"first_air_date"`
+	OriginCountry    []string `json:"origin_country"`
+	VoteAverage      float32  `json:"vote_average"`
+	VoteCount        int32    `json:"vote_count"`
+}
+
 type TvSeriesSimilarResponse struct {
-	Page         int32 `json:"page"`
-	TotalPages   int32 `json:"total_pages"`
-	TotalResults int32 `json:"total_results"`
-	Results      []struct {
-		Adult            bool     `json:"adult"`
-		BackdropPath     string   `json:"backdrop_path"`
-		GenreIds         []int32  `json:"genre_ids"`
-		ID               int32    `json:"id"`
-		MediaType        string   `json:"media_type"`
-		Name             string   `json:"name"`
-		OriginalLanguage string   `json:"original_language"`
-		OriginalName     string   `json:"original_name"`
-		Overview         string   `json:"overview"`
-		Popularity       float32  `json:"popularity"`
-		PosterPath       string   `json:"poster_path"`
-		FirstAirDate     string   `json:"first_air_date"`
-		OriginCountry    []string `json:"origin_country"`
-		VoteAverage      float32  `json:"vote_average"`
-		VoteCount        int32    `json:"vote_count"`
-	} `json:"results"`
+	Page         int32             `json:"page"`
+	TotalPages   int32             `json:"total_pages"`
+	TotalResults int32             `json:"total_results"`
+	Results      []SimilarTvSeries `json:"results"`
+}
+
+func (r *TvSeriesSimilarResponse) pageInfo() (page, totalPages int) {
+	return int(r.Page), int(r.TotalPages)
+}
+
+func (r *TvSeriesSimilarResponse) results() []SimilarTvSeries {
+	return r.Results
 }
 
 type TvSeriesTranslationsResponse struct {
@@ -481,7 +527,7 @@ type TvSeriesVideosResponse struct {
 
 // type TvSeriesWatchProvidersResponse struct {}
 
-func (tc *TvSeriesClient) GetDetails(ctx context.Context, seriesID int32, queryParams ...queryParam) (*TvSeriesDetailsResponse, error) {
+func (tc *TvSeriesClient) GetDetails(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*TvSeriesDetailsResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/%d", seriesID), queryParams...)
 	if err != nil {
 		return nil, err
@@ -495,7 +541,16 @@ func (tc *TvSeriesClient) GetDetails(ctx context.Context, seriesID int32, queryP
 	return &result, nil
 }
 
-func (tc *TvSeriesClient) GetAccountStates(ctx context.Context, seriesID int32, queryParams ...queryParam) (*TvSeriesAccountStatesResponse, error) {
+// GetDetailsWith fetches the details of a tv series together with the given AppendToResponse
+// sub-resources in a single request. Other query params, e.g. language, can be mixed in.
+func (tc *TvSeriesClient) GetDetailsWith(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*TvSeriesDetailsResponse, error) {
+	if err := checkAppends("tv series details", tvSeriesDetailsAppends, queryParams); err != nil {
+		return nil, err
+	}
+	return tc.GetDetails(ctx, seriesID, queryParams...)
+}
+
+func (tc *TvSeriesClient) GetAccountStates(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*TvSeriesAccountStatesResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/%d/account_states", seriesID), queryParams...)
 	if err != nil {
 		return nil, err
@@ -509,7 +564,7 @@ func (tc *TvSeriesClient) GetAccountStates(ctx context.Context, seriesID int32,
 	return &result, nil
 }
 
-func (tc *TvSeriesClient) GetAggregateCredits(ctx context.Context, seriesID int32, queryParams ...queryParam) (*TvSeriesAggregateCreditsResponse, error) {
+func (tc *TvSeriesClient) GetAggregateCredits(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*TvSeriesAggregateCreditsResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/%d/aggregate_credits", seriesID), queryParams...)
 	if err != nil {
 		return nil, err
@@ -537,7 +592,7 @@ func (tc *TvSeriesClient) GetAlternativeTitles(ctx context.Context, seriesID int
 	return &result, nil
 }
 
-func (tc *TvSeriesClient) GetChanges(ctx context.Context, seriesID int32, queryParams ...queryParam) (*TvSeriesChangesResponse, error) {
+func (tc *TvSeriesClient) GetChanges(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*TvSeriesChangesResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/%d/changes", seriesID), queryParams...)
 	if err != nil {
 		return nil, err
@@ -565,7 +620,7 @@ func (tc *TvSeriesClient) GetContentRatings(ctx context.Context, seriesID int32)
 	return &result, nil
 }
 
-func (tc *TvSeriesClient) GetCredits(ctx context.Context, seriesID int32, queryParams ...queryParam) (*TvSeriesCreditsResponse, error) {
+func (tc *TvSeriesClient) GetCredits(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*TvSeriesCreditsResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/%d/credits", seriesID), queryParams...)
 	if err != nil {
 		return nil, err
@@ -607,7 +662,7 @@ func (tc *TvSeriesClient) GetExternalIds(ctx context.Context, seriesID int32) (*
 	return &result, nil
 }
 
-func (tc *TvSeriesClient) GetImages(ctx context.Context, seriesID int32, queryParams ...queryParam) (*TvSeriesImagesResponse, error) {
+func (tc *TvSeriesClient) GetImages(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*TvSeriesImagesResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/%d/images", seriesID), queryParams...)
 	if err != nil {
 		return nil, err
@@ -649,7 +704,7 @@ func (tc *TvSeriesClient) GetLatest(ctx context.Context) (*TvSeriesLatestRespons
 	return &result, nil
 }
 
-func (tc *TvSeriesClient) GetRecommendations(ctx context.Context, seriesID int32, queryParams ...queryParam) (*TvSeriesRecommendationsResponse, error) {
+func (tc *TvSeriesClient) GetRecommendations(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*TvSeriesRecommendationsResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/%d/recommendations", seriesID), queryParams...)
 	if err != nil {
 		return nil, err
@@ -663,7 +718,7 @@ func (tc *TvSeriesClient) GetRecommendations(ctx context.Context, seriesID int32
 	return &result, nil
 }
 
-func (tc *TvSeriesClient) GetReviews(ctx context.Context, seriesID int32, queryParams ...queryParam) (*TvSeriesReviewsResponse, error) {
+func (tc *TvSeriesClient) GetReviews(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*TvSeriesReviewsResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/%d/reviews", seriesID), queryParams...)
 	if err != nil {
 		return nil, err
@@ -691,7 +746,7 @@ func (tc *TvSeriesClient) GetScreenedTheatrically(ctx context.Context, seriesID
 	return &result, nil
 }
 
-func (tc *TvSeriesClient) GetSimilar(ctx context.Context, seriesID int32, queryParams ...queryParam) (*TvSeriesSimilarResponse, error) {
+func (tc *TvSeriesClient) GetSimilar(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*TvSeriesSimilarResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/%d/similar", seriesID), queryParams...)
 	if err != nil {
 		return nil, err
@@ -719,7 +774,7 @@ func (tc *TvSeriesClient) GetTranslations(ctx context.Context, seriesID int32) (
 	return &result, nil
 }
 
-func (tc *TvSeriesClient) GetVideos(ctx context.Context, seriesID int32, queryParams ...queryParam) (*TvSeriesVideosResponse, error) {
+func (tc *TvSeriesClient) GetVideos(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*TvSeriesVideosResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, fmt.Sprintf("/tv/%d/videos", seriesID), queryParams...)
 	if err != nil {
 		return nil, err
@@ -732,3 +787,22 @@ func (tc *TvSeriesClient) GetVideos(ctx context.Context, seriesID int32, queryPa
 	}
 	return &result, nil
 }
+
+// AddRating rates the tv series between 0.5 and 10 in steps of 0.5, as the user of a SessionID or GuestSessionID.
+func (tc *TvSeriesClient) AddRating(ctx context.Context, seriesID int32, rating float64, queryParams ...QueryParam) (*WriteResponse, error) {
+	if err := validateRating(rating); err != nil {
+		return nil, err
+	}
+	if err := tc.baseClient.requireSession(queryParams, "session_id", "guest_session_id"); err != nil {
+		return nil, err
+	}
+	return tc.baseClient.write(ctx, http.MethodPost, fmt.Sprintf("/tv/%d/rating", seriesID), ratingBody{Value: rating}, queryParams...)
+}
+
+// DeleteRating removes the rating of the tv series by the user of a SessionID or GuestSessionID.
+func (tc *TvSeriesClient) DeleteRating(ctx context.Context, seriesID int32, queryParams ...QueryParam) (*WriteResponse, error) {
+	if err := tc.baseClient.requireSession(queryParams, "session_id", "guest_session_id"); err != nil {
+		return nil, err
+	}
+	return tc.baseClient.write(ctx, http.MethodDelete, fmt.Sprintf("/tv/%d/rating", seriesID), nil, queryParams...)
+}
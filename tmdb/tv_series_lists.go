@@ -7,10 +7,10 @@ import (
 )
 
 type TvSeriesListsService interface {
-	GetAiringToday(ctx context.Context, queryParams ...queryParam) (*TvSeriesAiringTodayResponse, error)
-	GetOnTheAir(ctx context.Context, queryParams ...queryParam) (*TvSeriesOnTheAirResponse, error)
-	GetPopular(ctx context.Context, queryParams ...queryParam) (*TvSeriesPopularResponse, error)
-	GetTopRated(ctx context.Context, queryParams ...queryParam) (*TvSeriesTopRatedResponse, error)
+	GetAiringToday(ctx context.Context, queryParams ...QueryParam) (*TvSeriesAiringTodayResponse, error)
+	GetOnTheAir(ctx context.Context, queryParams ...QueryParam) (*TvSeriesOnTheAirResponse, error)
+	GetPopular(ctx context.Context, queryParams ...QueryParam) (*TvSeriesPopularResponse, error)
+	GetTopRated(ctx context.Context, queryParams ...QueryParam) (*TvSeriesTopRatedResponse, error)
 }
 
 type TvSeriesListsClient struct {
@@ -40,6 +40,14 @@ type TvSeriesAiringTodayResponse struct {
 	TotalResults int              `json:"total_results"`
 }
 
+func (r *TvSeriesAiringTodayResponse) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *TvSeriesAiringTodayResponse) results() []TvSeriesResult {
+	return r.Results
+}
+
 type TvSeriesOnTheAirResponse struct {
 	Page         int              `json:"page"`
 	Results      []TvSeriesResult `json:"results"`
@@ -47,6 +55,14 @@ type TvSeriesOnTheAirResponse struct {
 	TotalResults int              `json:"total_results"`
 }
 
+func (r *TvSeriesOnTheAirResponse) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *TvSeriesOnTheAirResponse) results() []TvSeriesResult {
+	return r.Results
+}
+
 type TvSeriesPopularResponse struct {
 	Page         int              `json:"page"`
 	Results      []TvSeriesResult `json:"results"`
@@ -54,6 +70,14 @@ type TvSeriesPopularResponse struct {
 	TotalResults int              `json:"total_results"`
 }
 
+func (r *TvSeriesPopularResponse) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *TvSeriesPopularResponse) results() []TvSeriesResult {
+	return r.Results
+}
+
 type TvSeriesTopRatedResponse struct {
 	Page         int              `json:"page"`
 	Results      []TvSeriesResult `json:"results"`
@@ -61,7 +85,15 @@ type TvSeriesTopRatedResponse struct {
 	TotalResults int              `json:"total_results"`
 }
 
-func (tc *TvSeriesListsClient) GetAiringToday(ctx context.Context, queryParams ...queryParam) (*TvSeriesAiringTodayResponse, error) {
+func (r *TvSeriesTopRatedResponse) pageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+func (r *TvSeriesTopRatedResponse) results() []TvSeriesResult {
+	return r.Results
+}
+
+func (tc *TvSeriesListsClient) GetAiringToday(ctx context.Context, queryParams ...QueryParam) (*TvSeriesAiringTodayResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, "/tv/airing_today", queryParams...)
 	if err != nil {
 		return nil, err
@@ -75,7 +107,7 @@ func (tc *TvSeriesListsClient) GetAiringToday(ctx context.Context, queryParams .
 	return &result, nil
 }
 
-func (tc *TvSeriesListsClient) GetOnTheAir(ctx context.Context, queryParams ...queryParam) (*TvSeriesOnTheAirResponse, error) {
+func (tc *TvSeriesListsClient) GetOnTheAir(ctx context.Context, queryParams ...QueryParam) (*TvSeriesOnTheAirResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, "/tv/on_the_air", queryParams...)
 	if err != nil {
 		return nil, err
@@ -89,7 +121,7 @@ func (tc *TvSeriesListsClient) GetOnTheAir(ctx context.Context, queryParams ...q
 	return &result, nil
 }
 
-func (tc *TvSeriesListsClient) GetPopular(ctx context.Context, queryParams ...queryParam) (*TvSeriesPopularResponse, error) {
+func (tc *TvSeriesListsClient) GetPopular(ctx context.Context, queryParams ...QueryParam) (*TvSeriesPopularResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, "/tv/popular", queryParams...)
 	if err != nil {
 		return nil, err
@@ -103,7 +135,7 @@ func (tc *TvSeriesListsClient) GetPopular(ctx context.Context, queryParams ...qu
 	return &result, nil
 }
 
-func (tc *TvSeriesListsClient) GetTopRated(ctx context.Context, queryParams ...queryParam) (*TvSeriesTopRatedResponse, error) {
+func (tc *TvSeriesListsClient) GetTopRated(ctx context.Context, queryParams ...QueryParam) (*TvSeriesTopRatedResponse, error) {
 	resp, err := tc.baseClient.request(ctx, http.MethodGet, "/tv/top_rated", queryParams...)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,184 @@
+package tmdb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// V4AccountService reads the lists, favorites, ratings, recommendations and watchlists of a user
+// through version 4 of the API. accountObjectID is the account_id returned by
+// V4Auth.CreateAccessToken, and the client must act as that user, see Client.WithAccessToken.
+type V4AccountService interface {
+	GetLists(ctx context.Context, accountObjectID string, queryParams ...QueryParam) (*V4AccountListsResponse, error)
+	GetFavoriteMovies(ctx context.Context, accountObjectID string, queryParams ...QueryParam) (*V4AccountMoviesResponse, error)
+	GetFavoriteTv(ctx context.Context, accountObjectID string, queryParams ...QueryParam) (*V4AccountTvResponse, error)
+	GetRatedMovies(ctx context.Context, accountObjectID string, queryParams ...QueryParam) (*V4AccountMoviesResponse, error)
+	GetRatedTv(ctx context.Context, accountObjectID string, queryParams ...QueryParam) (*V4AccountTvResponse, error)
+	GetMovieRecommendations(ctx context.Context, accountObjectID string, queryParams ...QueryParam) (*V4AccountMoviesResponse, error)
+	GetTvRecommendations(ctx context.Context, accountObjectID string, queryParams ...QueryParam) (*V4AccountTvResponse, error)
+	GetMovieWatchlist(ctx context.Context, accountObjectID string, queryParams ...QueryParam) (*V4AccountMoviesResponse, error)
+	GetTvWatchlist(ctx context.Context, accountObjectID string, queryParams ...QueryParam) (*V4AccountTvResponse, error)
+}
+
+type V4AccountClient struct {
+	baseClient *Client
+}
+
+// V4AccountList is a list of the user. Adult, Featured and Public are 1 when set, 0 otherwise.
+type V4AccountList struct {
+	AccountObjectID string  `json:"account_object_id"`
+	Adult           int32   `json:"adult"`
+	AverageRating   float64 `json:"average_rating"`
+	BackdropPath    string  `json:"backdrop_path"`
+	CreatedAt       string  `json:"created_at"`
+	Description     string  `json:"description"`
+	Featured        int32   `json:"featured"`
+	ID              int32   `json:"id"`
+	Iso_3166_1      string  `json:"iso_3166_1"`
+	Iso_639_1       string  `json:"iso_639_1"`
+	Name            string  `json:"name"`
+	NumberOfItems   int32   `json:"number_of_items"`
+	PosterPath      string  `json:"poster_path"`
+	Public          int32   `json:"public"`
+	SortBy          int32   `json:"sort_by"`
+	UpdatedAt       string  `json:"updated_at"`
+}
+
+type V4AccountListsResponse struct {
+	Page         int32           `json:"page"`
+	Results      []V4AccountList `json:"results"`
+	TotalPages   int32           `json:"total_pages"`
+	TotalResults int32           `json:"total_results"`
+}
+
+func (r *V4AccountListsResponse) pageInfo() (page, totalPages int) {
+	return int(r.Page), int(r.TotalPages)
+}
+
+func (r *V4AccountListsResponse) results() []V4AccountList {
+	return r.Results
+}
+
+// V4AccountRating is the rating the user gave, only set on rated movies and tv series.
+type V4AccountRating struct {
+	CreatedAt string  `json:"created_at"`
+	Value     float64 `json:"value"`
+}
+
+type V4AccountMovie struct {
+	MovieResult
+	AccountRating *V4AccountRating `json:"account_rating,omitempty"`
+}
+
+type V4AccountMoviesResponse struct {
+	Page         int32            `json:"page"`
+	Results      []V4AccountMovie `json:"results"`
+	TotalPages   int32            `json:"total_pages"`
+	TotalResults int32            `json:"total_results"`
+}
+
+func (r *V4AccountMoviesResponse) pageInfo() (page, totalPages int) {
+	return int(r.Page), int(r.TotalPages)
+}
+
+func (r *V4AccountMoviesResponse) results() []V4AccountMovie {
+	return r.Results
+}
+
+type V4AccountTv struct {
+	TvResult
+	AccountRating *V4AccountRating `json:"account_rating,omitempty"`
+}
+
+type V4AccountTvResponse struct {
+	Page         int32         `json:"page"`
+	Results      []V4AccountTv `json:"results"`
+	TotalPages   int32         `json:"total_pages"`
+	TotalResults int32         `json:"total_results"`
+}
+
+func (r *V4AccountTvResponse) pageInfo() (page, totalPages int) {
+	return int(r.Page), int(r.TotalPages)
+}
+
+func (r *V4AccountTvResponse) results() []V4AccountTv {
+	return r.Results
+}
+
+func (ac *V4AccountClient) GetLists(ctx context.Context, accountObjectID string, queryParams ...QueryParam) (*V4AccountListsResponse, error) {
+	resp, err := ac.baseClient.requestV4(ctx, http.MethodGet, fmt.Sprintf("/account/%s/lists", accountObjectID), nil, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result V4AccountListsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (ac *V4AccountClient) GetFavoriteMovies(ctx context.Context, accountObjectID string, queryParams ...QueryParam) (*V4AccountMoviesResponse, error) {
+	return ac.getMovies(ctx, accountObjectID, "favorites", queryParams)
+}
+
+func (ac *V4AccountClient) GetFavoriteTv(ctx context.Context, accountObjectID string, queryParams ...QueryParam) (*V4AccountTvResponse, error) {
+	return ac.getTv(ctx, accountObjectID, "favorites", queryParams)
+}
+
+// GetRatedMovies returns the movies the user rated, with their rating in AccountRating.
+func (ac *V4AccountClient) GetRatedMovies(ctx context.Context, accountObjectID string, queryParams ...QueryParam) (*V4AccountMoviesResponse, error) {
+	return ac.getMovies(ctx, accountObjectID, "rated", queryParams)
+}
+
+// GetRatedTv returns the tv series the user rated, with their rating in AccountRating.
+func (ac *V4AccountClient) GetRatedTv(ctx context.Context, accountObjectID string, queryParams ...QueryParam) (*V4AccountTvResponse, error) {
+	return ac.getTv(ctx, accountObjectID, "rated", queryParams)
+}
+
+func (ac *V4AccountClient) GetMovieRecommendations(ctx context.Context, accountObjectID string, queryParams ...QueryParam) (*V4AccountMoviesResponse, error) {
+	return ac.getMovies(ctx, accountObjectID, "recommendations", queryParams)
+}
+
+func (ac *V4AccountClient) GetTvRecommendations(ctx context.Context, accountObjectID string, queryParams ...QueryParam) (*V4AccountTvResponse, error) {
+	return ac.getTv(ctx, accountObjectID, "recommendations", queryParams)
+}
+
+func (ac *V4AccountClient) GetMovieWatchlist(ctx context.Context, accountObjectID string, queryParams ...QueryParam) (*V4AccountMoviesResponse, error) {
+	return ac.getMovies(ctx, accountObjectID, "watchlist", queryParams)
+}
+
+func (ac *V4AccountClient) GetTvWatchlist(ctx context.Context, accountObjectID string, queryParams ...QueryParam) (*V4AccountTvResponse, error) {
+	return ac.getTv(ctx, accountObjectID, "watchlist", queryParams)
+}
+
+func (ac *V4AccountClient) getMovies(ctx context.Context, accountObjectID, list string, queryParams []QueryParam) (*V4AccountMoviesResponse, error) {
+	resp, err := ac.baseClient.requestV4(ctx, http.MethodGet, fmt.Sprintf("/account/%s/movie/%s", accountObjectID, list), nil, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result V4AccountMoviesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (ac *V4AccountClient) getTv(ctx context.Context, accountObjectID, list string, queryParams []QueryParam) (*V4AccountTvResponse, error) {
+	resp, err := ac.baseClient.requestV4(ctx, http.MethodGet, fmt.Sprintf("/account/%s/tv/%s", accountObjectID, list), nil, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result V4AccountTvResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,88 @@
+package tmdb
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// V4AuthService is the user authentication flow of version 4 of the API. Unlike v3 sessions,
+// a v4 access token is sent as bearer token, see Client.WithAccessToken.
+type V4AuthService interface {
+	CreateRequestToken(ctx context.Context, redirectTo string) (*V4RequestTokenResponse, error)
+	CreateAccessToken(ctx context.Context, requestToken string) (*V4AccessTokenResponse, error)
+	DeleteAccessToken(ctx context.Context, accessToken string) (*WriteResponse, error)
+}
+
+type V4AuthClient struct {
+	baseClient *Client
+}
+
+type V4RequestTokenResponse struct {
+	Success       bool   `json:"success"`
+	StatusCode    int    `json:"status_code"`
+	StatusMessage string `json:"status_message"`
+	RequestToken  string `json:"request_token"`
+}
+
+type V4AccessTokenResponse struct {
+	Success       bool   `json:"success"`
+	StatusCode    int    `json:"status_code"`
+	StatusMessage string `json:"status_message"`
+	AccountID     string `json:"account_id"`
+	AccessToken   string `json:"access_token"`
+}
+
+// WithAccessToken returns a client sending accessToken, e.g. from V4Auth.CreateAccessToken,
+// as its bearer token, so it reaches the v4 account and list endpoints of the user. Its
+// responses are never cached.
+func (c *Client) WithAccessToken(accessToken string) *Client {
+	return c.derive(func(c *Client) {
+		c.clientType = BearerAuth
+		c.token = accessToken
+		c.userAccessToken = true
+	})
+}
+
+// CreateRequestToken starts the login of a user. Send the user to
+// https://www.themoviedb.org/auth/access?request_token={request_token} to approve it, after which
+// they are sent to redirectTo, if set. The request token is valid for 15 minutes.
+func (ac *V4AuthClient) CreateRequestToken(ctx context.Context, redirectTo string) (*V4RequestTokenResponse, error) {
+	var body map[string]string
+	if redirectTo != "" {
+		body = map[string]string{"redirect_to": redirectTo}
+	}
+
+	resp, err := ac.baseClient.requestV4(ctx, http.MethodPost, "/auth/request_token", body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result V4RequestTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateAccessToken exchanges a request token the user approved for an access token. Access
+// tokens don't expire, keep them like a password until DeleteAccessToken is called.
+func (ac *V4AuthClient) CreateAccessToken(ctx context.Context, requestToken string) (*V4AccessTokenResponse, error) {
+	resp, err := ac.baseClient.requestV4(ctx, http.MethodPost, "/auth/access_token", map[string]string{"request_token": requestToken})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result V4AccessTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteAccessToken logs the user of accessToken out.
+func (ac *V4AuthClient) DeleteAccessToken(ctx context.Context, accessToken string) (*WriteResponse, error) {
+	return ac.baseClient.writeV4(ctx, http.MethodDelete, "/auth/access_token", map[string]string{"access_token": accessToken})
+}
@@ -0,0 +1,253 @@
+package tmdb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// V4ListsService manages the lists of a user through version 4 of the API. Unlike v3 lists,
+// v4 lists hold movies and tv series, can be private and carry a comment per item. All methods
+// need a client acting as the owner of the list, see Client.WithAccessToken, except GetList
+// and CheckItemStatus for public lists.
+type V4ListsService interface {
+	GetList(ctx context.Context, listID int32, queryParams ...QueryParam) (*V4ListResponse, error)
+	Create(ctx context.Context, list V4ListCreate) (*V4ListCreateResponse, error)
+	Update(ctx context.Context, listID int32, list V4ListUpdate) (*WriteResponse, error)
+	Clear(ctx context.Context, listID int32) (*V4ListClearResponse, error)
+	Delete(ctx context.Context, listID int32) (*WriteResponse, error)
+	AddItems(ctx context.Context, listID int32, items ...V4ListItem) (*V4ListItemsResponse, error)
+	UpdateItems(ctx context.Context, listID int32, items ...V4ListItem) (*V4ListItemsResponse, error)
+	RemoveItems(ctx context.Context, listID int32, items ...V4ListItem) (*V4ListItemsResponse, error)
+	CheckItemStatus(ctx context.Context, listID int32, mediaType string, mediaID int32) (*V4ListItemStatusResponse, error)
+}
+
+type V4ListsClient struct {
+	baseClient *Client
+}
+
+type V4ListCreator struct {
+	AvatarPath   string `json:"avatar_path"`
+	GravatarHash string `json:"gravatar_hash"`
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Username     string `json:"username"`
+}
+
+type V4ListResponse struct {
+	AverageRating float64           `json:"average_rating"`
+	BackdropPath  string            `json:"backdrop_path"`
+	Comments      map[string]string `json:"comments"`
+	CreatedBy     V4ListCreator     `json:"created_by"`
+	Description   string            `json:"description"`
+	ID            int32             `json:"id"`
+	Iso_3166_1    string            `json:"iso_3166_1"`
+	Iso_639_1     string            `json:"iso_639_1"`
+	ItemCount     int32             `json:"item_count"`
+	Name          string            `json:"name"`
+	Page          int32             `json:"page"`
+	PosterPath    string            `json:"poster_path"`
+	Public        bool              `json:"public"`
+	Results       MediaResults      `json:"results"`
+	Revenue       int64             `json:"revenue"`
+	Runtime       int32             `json:"runtime"`
+	SortBy        string            `json:"sort_by"`
+	TotalPages    int32             `json:"total_pages"`
+	TotalResults  int32             `json:"total_results"`
+}
+
+func (r *V4ListResponse) pageInfo() (page, totalPages int) {
+	return int(r.Page), int(r.TotalPages)
+}
+
+func (r *V4ListResponse) results() []MediaResult {
+	return r.Results
+}
+
+// Comment returns the comment on the item of mediaType and mediaID, if any.
+func (r *V4ListResponse) Comment(mediaType string, mediaID int32) string {
+	return r.Comments[fmt.Sprintf("%s:%d", mediaType, mediaID)]
+}
+
+// V4ListCreate is a new list. Name and Iso_639_1 are required.
+type V4ListCreate struct {
+	Name        string `json:"name"`
+	Iso_639_1   string `json:"iso_639_1"`
+	Iso_3166_1  string `json:"iso_3166_1,omitempty"`
+	Description string `json:"description,omitempty"`
+	Public      bool   `json:"public"`
+}
+
+// V4ListUpdate changes the details of a list. Fields left empty are not changed.
+type V4ListUpdate struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Public      *bool  `json:"public,omitempty"`
+	SortBy      string `json:"sort_by,omitempty"`
+}
+
+type V4ListCreateResponse struct {
+	Success       bool   `json:"success"`
+	StatusCode    int    `json:"status_code"`
+	StatusMessage string `json:"status_message"`
+	ID            int32  `json:"id"`
+}
+
+type V4ListClearResponse struct {
+	Success       bool   `json:"success"`
+	StatusCode    int    `json:"status_code"`
+	StatusMessage string `json:"status_message"`
+	ID            int32  `json:"id"`
+	ItemsDeleted  int32  `json:"items_deleted"`
+}
+
+// V4ListItem is a movie or tv series on a list. MediaType is MediaTypeMovie or MediaTypeTv,
+// Comment is only used by UpdateItems.
+type V4ListItem struct {
+	MediaType string `json:"media_type"`
+	MediaID   int32  `json:"media_id"`
+	Comment   string `json:"comment,omitempty"`
+}
+
+type V4ListItemResult struct {
+	MediaType string `json:"media_type"`
+	MediaID   int32  `json:"media_id"`
+	Success   bool   `json:"success"`
+}
+
+// V4ListItemsResponse acknowledges changes to the items of a list, with the outcome per item.
+type V4ListItemsResponse struct {
+	Success       bool               `json:"success"`
+	StatusCode    int                `json:"status_code"`
+	StatusMessage string             `json:"status_message"`
+	Results       []V4ListItemResult `json:"results"`
+}
+
+type V4ListItemStatusResponse struct {
+	Success       bool   `json:"success"`
+	StatusCode    int    `json:"status_code"`
+	StatusMessage string `json:"status_message"`
+	ID            int32  `json:"id"`
+	MediaType     string `json:"media_type"`
+	MediaID       int32  `json:"media_id"`
+}
+
+type v4ListItemsBody struct {
+	Items []V4ListItem `json:"items"`
+}
+
+// GetList returns a page of the items of a list, which may be movies and tv series.
+func (lc *V4ListsClient) GetList(ctx context.Context, listID int32, queryParams ...QueryParam) (*V4ListResponse, error) {
+	resp, err := lc.baseClient.requestV4(ctx, http.MethodGet, fmt.Sprintf("/list/%d", listID), nil, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result V4ListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (lc *V4ListsClient) Create(ctx context.Context, list V4ListCreate) (*V4ListCreateResponse, error) {
+	if list.Name == "" || list.Iso_639_1 == "" {
+		return nil, fmt.Errorf("%w: a list needs a name and iso_639_1", ErrInvalidQueryParams)
+	}
+
+	resp, err := lc.baseClient.requestV4(ctx, http.MethodPost, "/list", list)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result V4ListCreateResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (lc *V4ListsClient) Update(ctx context.Context, listID int32, list V4ListUpdate) (*WriteResponse, error) {
+	return lc.baseClient.writeV4(ctx, http.MethodPut, fmt.Sprintf("/list/%d", listID), list)
+}
+
+// Clear removes every item of a list, keeping the list itself. It is never retried.
+func (lc *V4ListsClient) Clear(ctx context.Context, listID int32) (*V4ListClearResponse, error) {
+	resp, err := lc.baseClient.requestV4(ctx, http.MethodGet, fmt.Sprintf("/list/%d/clear", listID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result V4ListClearResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (lc *V4ListsClient) Delete(ctx context.Context, listID int32) (*WriteResponse, error) {
+	return lc.baseClient.writeV4(ctx, http.MethodDelete, fmt.Sprintf("/list/%d", listID), nil)
+}
+
+func (lc *V4ListsClient) AddItems(ctx context.Context, listID int32, items ...V4ListItem) (*V4ListItemsResponse, error) {
+	return lc.writeItems(ctx, http.MethodPost, listID, items)
+}
+
+// UpdateItems sets the comment of items already on a list.
+func (lc *V4ListsClient) UpdateItems(ctx context.Context, listID int32, items ...V4ListItem) (*V4ListItemsResponse, error) {
+	return lc.writeItems(ctx, http.MethodPut, listID, items)
+}
+
+func (lc *V4ListsClient) RemoveItems(ctx context.Context, listID int32, items ...V4ListItem) (*V4ListItemsResponse, error) {
+	return lc.writeItems(ctx, http.MethodDelete, listID, items)
+}
+
+func (lc *V4ListsClient) writeItems(ctx context.Context, method string, listID int32, items []V4ListItem) (*V4ListItemsResponse, error) {
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%w: no list items given", ErrInvalidQueryParams)
+	}
+	for _, item := range items {
+		if err := checkAccountMediaType(item.MediaType); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := lc.baseClient.requestV4(ctx, method, fmt.Sprintf("/list/%d/items", listID), v4ListItemsBody{Items: items})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result V4ListItemsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CheckItemStatus tells whether the movie or tv series mediaID is on a list. TMDb answers
+// with ErrNotFound when it is not.
+func (lc *V4ListsClient) CheckItemStatus(ctx context.Context, listID int32, mediaType string, mediaID int32) (*V4ListItemStatusResponse, error) {
+	if err := checkAccountMediaType(mediaType); err != nil {
+		return nil, err
+	}
+
+	resp, err := lc.baseClient.requestV4(ctx, http.MethodGet, fmt.Sprintf("/list/%d/item_status", listID), nil,
+		SingleQueryParam{Key: "media_type", Value: mediaType},
+		SingleQueryParam{Key: "media_id", Value: fmt.Sprint(mediaID)},
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result V4ListItemStatusResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,190 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestV4Client(t *testing.T) {
+	t.Run("Login flow and user scoped lists", func(t *testing.T) {
+		var (
+			requests []recordedWrite
+			tokens   []string
+		)
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			requests = append(requests, recordedWrite{r.Method, r.URL.Path, r.URL.RawQuery, string(body)})
+			tokens = append(tokens, r.Header.Get("Authorization"))
+			switch r.URL.Path {
+			case "/4/auth/request_token":
+				_, _ = w.Write([]byte(`{"success":true,"status_code":1,"status_message":"Success.","request_token":"request"}`))
+			case "/4/auth/access_token":
+				_, _ = w.Write([]byte(`{"success":true,"status_code":1,"status_message":"Success.","account_id":"4bc889XXXXXXXX","access_token":"user"}`))
+			case "/4/list":
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{"success":true,"status_code":1,"status_message":"The item/record was created successfully.","id":7049}`))
+			case "/4/list/7049/items":
+				_, _ = w.Write([]byte(`{"success":true,"status_code":1,"status_message":"Success.","results":[{"media_type":"movie","media_id":18148,"success":true},{"media_type":"tv","media_id":1396,"success":true}]}`))
+			case "/4/list/7049":
+				_, _ = w.Write([]byte(`{"id":7049,"name":"Favorites","page":1,"total_pages":1,"total_results":2,"comments":{"movie:18148":"A classic","tv:1396":null},"results":[{"media_type":"movie","id":18148,"title":"Tokyo Story"},{"media_type":"tv","id":1396,"name":"Breaking Bad"}]}`))
+			case "/4/account/4bc889XXXXXXXX/movie/rated":
+				_, _ = w.Write([]byte(`{"page":1,"total_pages":1,"total_results":1,"results":[{"id":18148,"title":"Tokyo Story","account_rating":{"created_at":"2024-05-15T09:00:00.000Z","value":9}}]}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}, WithCache(NewLRUCache(10)))
+		defer testServer.Close()
+
+		ctx := context.Background()
+		requestToken, err := testClient.V4Auth.CreateRequestToken(ctx, "http://localhost/approved")
+		if err != nil {
+			t.Fatal(err)
+		}
+		accessToken, err := testClient.V4Auth.CreateAccessToken(ctx, requestToken.RequestToken)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		userClient := testClient.WithAccessToken(accessToken.AccessToken)
+		list, err := userClient.V4Lists.Create(ctx, V4ListCreate{Name: "Favorites", Iso_639_1: "en"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if list.ID != 7049 {
+			t.Errorf("expected list 7049, got %d", list.ID)
+		}
+
+		items, err := userClient.V4Lists.AddItems(ctx, list.ID, V4ListItem{MediaType: MediaTypeMovie, MediaID: 18148}, V4ListItem{MediaType: MediaTypeTv, MediaID: 1396})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(items.Results) != 2 || !items.Results[1].Success {
+			t.Errorf("expected 2 added items, got %+v", items.Results)
+		}
+
+		for i := 0; i < 2; i++ {
+			details, err := userClient.V4Lists.GetList(ctx, list.ID, SingleQueryParam{Key: "page", Value: "1"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if movie, ok := details.Results[0].(*MovieResult); !ok || movie.Title != "Tokyo Story" {
+				t.Errorf("expected Tokyo Story, got %+v", details.Results[0])
+			}
+			if comment := details.Comment(MediaTypeMovie, 18148); comment != "A classic" {
+				t.Errorf("expected a comment, got %q", comment)
+			}
+		}
+
+		rated, err := userClient.V4Account.GetRatedMovies(ctx, accessToken.AccountID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rated.Results) != 1 || rated.Results[0].Title != "Tokyo Story" || rated.Results[0].AccountRating.Value != 9 {
+			t.Errorf("expected a rating of Tokyo Story, got %+v", rated.Results)
+		}
+
+		if _, err := testClient.V4Auth.DeleteAccessToken(ctx, accessToken.AccessToken); err != nil {
+			t.Fatal(err)
+		}
+
+		expected := []recordedWrite{
+			{http.MethodPost, "/4/auth/request_token", "", `{"redirect_to":"http://localhost/approved"}`},
+			{http.MethodPost, "/4/auth/access_token", "", `{"request_token":"request"}`},
+			{http.MethodPost, "/4/list", "", `{"name":"Favorites","iso_639_1":"en","public":false}`},
+			{http.MethodPost, "/4/list/7049/items", "", `{"items":[{"media_type":"movie","media_id":18148},{"media_type":"tv","media_id":1396}]}`},
+			{http.MethodGet, "/4/list/7049", "page=1", ""},
+			{http.MethodGet, "/4/list/7049", "page=1", ""},
+			{http.MethodGet, "/4/account/4bc889XXXXXXXX/movie/rated", "", ""},
+			{http.MethodDelete, "/4/auth/access_token", "", `{"access_token":"user"}`},
+		}
+		expectedTokens := []string{"Bearer test", "Bearer test", "Bearer user", "Bearer user", "Bearer user", "Bearer user", "Bearer user", "Bearer test"}
+		if len(requests) != len(expected) {
+			t.Fatalf("expected %d requests, got %+v", len(expected), requests)
+		}
+		for i, request := range expected {
+			if requests[i] != request {
+				t.Errorf("expected %+v, got %+v", request, requests[i])
+			}
+			if tokens[i] != expectedTokens[i] {
+				t.Errorf("expected %s on %s, got %s", expectedTokens[i], request.path, tokens[i])
+			}
+		}
+	})
+
+	t.Run("Needs a bearer token", func(t *testing.T) {
+		var calls int32
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+		})
+		defer testServer.Close()
+
+		baseUrl, _ := url.Parse(testServer.URL)
+		apiKeyClient, err := NewClientWithApiKey("key", WithBaseUrl(baseUrl))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := apiKeyClient.V4Lists.GetList(context.Background(), 7049); !errors.Is(err, ErrBearerTokenMissing) {
+			t.Errorf("expected ErrBearerTokenMissing, got %v", err)
+		}
+		if _, err := testClient.V4Lists.AddItems(context.Background(), 7049, V4ListItem{MediaType: MediaTypePerson, MediaID: 1}); !errors.Is(err, ErrInvalidQueryParams) {
+			t.Errorf("expected ErrInvalidQueryParams, got %v", err)
+		}
+		if calls != 0 {
+			t.Errorf("expected no requests, got %d", calls)
+		}
+	})
+
+	t.Run("Does not retry clearing a list", func(t *testing.T) {
+		var calls int32
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}, WithRetryBackoff(time.Millisecond, 5*time.Millisecond))
+		defer testServer.Close()
+
+		if _, err := testClient.V4Lists.Clear(context.Background(), 7049); !errors.Is(err, ErrServerError) {
+			t.Errorf("expected ErrServerError, got %v", err)
+		}
+		if calls != 1 {
+			t.Errorf("expected 1 call, got %d", calls)
+		}
+	})
+
+	t.Run("Shares retries and errors with v3", func(t *testing.T) {
+		var calls int32
+		testClient, testServer := newTestClientAndServerWithHandler(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/4/list/7049/item_status" {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"success":false,"status_code":34,"status_message":"The resource you requested could not be found."}`))
+				return
+			}
+			if atomic.AddInt32(&calls, 1) < 2 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			_, _ = w.Write([]byte(`{"success":true,"status_code":12,"status_message":"The item/record was updated successfully."}`))
+		}, WithRetryBackoff(time.Millisecond, 5*time.Millisecond))
+		defer testServer.Close()
+
+		public := true
+		result, err := testClient.V4Lists.Update(context.Background(), 7049, V4ListUpdate{Public: &public})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !result.Success || calls != 2 {
+			t.Errorf("expected success after a retry, got %+v after %d calls", result, calls)
+		}
+
+		_, err = testClient.V4Lists.CheckItemStatus(context.Background(), 7049, MediaTypeMovie, 18148)
+		var tmdbErr *TmdbError
+		if !errors.Is(err, ErrNotFound) || !errors.As(err, &tmdbErr) || tmdbErr.StatusCode != 34 {
+			t.Errorf("expected a not found TmdbError, got %v", err)
+		}
+	})
+}
@@ -7,9 +7,9 @@ import (
 )
 
 type WatchProvidersService interface {
-	GetAvailableRegions(ctx context.Context, queryParams ...queryParam) (*WatchProvidersAvailableRegionsResponse, error)
-	GetMovieProviders(ctx context.Context, queryParams ...queryParam) (*WatchProvidersMovieProvidersResponse, error)
-	GetTVProviders(ctx context.Context, queryParams ...queryParam) (*WatchProvidersTVProvidersResponse, error)
+	GetAvailableRegions(ctx context.Context, queryParams ...QueryParam) (*WatchProvidersAvailableRegionsResponse, error)
+	GetMovieProviders(ctx context.Context, queryParams ...QueryParam) (*WatchProvidersMovieProvidersResponse, error)
+	GetTVProviders(ctx context.Context, queryParams ...QueryParam) (*WatchProvidersTVProvidersResponse, error)
 }
 
 type WatchProvidersClient struct {
@@ -110,7 +110,7 @@ type WatchProvidersTVProvidersResponse struct {
 	} `json:"results"`
 }
 
-func (wpc *WatchProvidersClient) GetAvailableRegions(ctx context.Context, queryParams ...queryParam) (*WatchProvidersAvailableRegionsResponse, error) {
+func (wpc *WatchProvidersClient) GetAvailableRegions(ctx context.Context, queryParams ...QueryParam) (*WatchProvidersAvailableRegionsResponse, error) {
 	resp, err := wpc.baseClient.request(ctx, http.MethodGet, "/watch/providers/regions", queryParams...)
 	if err != nil {
 		return nil, err
@@ -124,7 +124,7 @@ func (wpc *WatchProvidersClient) GetAvailableRegions(ctx context.Context, queryP
 	return &result, nil
 }
 
-func (wpc *WatchProvidersClient) GetMovieProviders(ctx context.Context, queryParams ...queryParam) (*WatchProvidersMovieProvidersResponse, error) {
+func (wpc *WatchProvidersClient) GetMovieProviders(ctx context.Context, queryParams ...QueryParam) (*WatchProvidersMovieProvidersResponse, error) {
 	resp, err := wpc.baseClient.request(ctx, http.MethodGet, "/watch/providers/movie", queryParams...)
 	if err != nil {
 		return nil, err
@@ -138,7 +138,7 @@ func (wpc *WatchProvidersClient) GetMovieProviders(ctx context.Context, queryPar
 	return &result, nil
 }
 
-func (wpc *WatchProvidersClient) GetTVProviders(ctx context.Context, queryParams ...queryParam) (*WatchProvidersTVProvidersResponse, error) {
+func (wpc *WatchProvidersClient) GetTVProviders(ctx context.Context, queryParams ...QueryParam) (*WatchProvidersTVProvidersResponse, error) {
 	resp, err := wpc.baseClient.request(ctx, http.MethodGet, "/watch/providers/tv", queryParams...)
 	if err != nil {
 		return nil, err